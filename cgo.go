@@ -21,6 +21,34 @@ typedef struct {
     char* error; // NULL if no error
 } RsaKeyPair;
 
+// ECDSA密钥对结构
+typedef struct {
+    ByteArray publicKey;
+    ByteArray privateKey;
+    char* error; // NULL if no error
+} EcdsaKeyPair;
+
+// Ed25519密钥对结构
+typedef struct {
+    ByteArray publicKey;
+    ByteArray privateKey;
+    char* error; // NULL if no error
+} Ed25519KeyPair;
+
+// ECDH密钥对结构
+typedef struct {
+    ByteArray publicKey;
+    ByteArray privateKey;
+    char* error; // NULL if no error
+} EcdhKeyPair;
+
+// SM2密钥对结构
+typedef struct {
+    ByteArray publicKey;
+    ByteArray privateKey;
+    char* error; // NULL if no error
+} Sm2KeyPair;
+
 // 字符串结果结构
 typedef struct {
     char* data;
@@ -32,10 +60,121 @@ typedef struct {
     int success; // 1 for true, 0 for false
     char* error; // NULL if no error
 } BoolResult;
+
+// 句柄结果结构，用于流式摘要和签名
+typedef struct {
+    unsigned long long handle; // 0 if error
+    char* error; // NULL if no error
+} HandleResult;
+
+// 整数结果结构
+typedef struct {
+    long long value;
+    char* error; // NULL if no error
+} IntResult;
+
+// RSA摘要算法，0表示使用默认值
+typedef enum {
+    RSA_HASH_DEFAULT = 0,
+    RSA_HASH_SHA1 = 1,
+    RSA_HASH_SHA256 = 2,
+    RSA_HASH_SHA512 = 3,
+    RSA_HASH_SHA384 = 4,
+    RSA_HASH_SHA224 = 5,
+    RSA_HASH_SHA3_256 = 6,
+} RsaHashType;
+
+// RSA私钥格式
+typedef enum {
+    RSA_PRIVATE_KEY_PKCS1 = 0,
+    RSA_PRIVATE_KEY_PKCS8 = 1,
+} RsaPrivateKeyFormat;
+
+// RSA公钥格式
+typedef enum {
+    RSA_PUBLIC_KEY_PKIX = 0,
+    RSA_PUBLIC_KEY_PKCS1 = 1,
+} RsaPublicKeyFormat;
+
+// 密钥输出编码，PEM和Base64时ByteArray内容为文本
+typedef enum {
+    RSA_KEY_ENCODING_DER = 0,
+    RSA_KEY_ENCODING_PEM = 1,
+    RSA_KEY_ENCODING_BASE64 = 2,
+} RsaKeyEncoding;
+
+// RSA签名填充方式
+typedef enum {
+    RSA_PADDING_PKCS1V15 = 0,
+    RSA_PADDING_PSS = 1,
+} RsaPaddingType;
+
+// 私钥加密的密钥派生算法
+typedef enum {
+    RSA_KDF_PBKDF2 = 0,
+    RSA_KDF_SCRYPT = 1,
+} RsaKeyDerivation;
+
+// ECDSA曲线，0表示使用默认值P-256
+typedef enum {
+    ECDSA_CURVE_DEFAULT = 0,
+    ECDSA_CURVE_P256 = 1,
+    ECDSA_CURVE_P384 = 2,
+    ECDSA_CURVE_P521 = 3,
+} EcdsaCurve;
+
+// ECDH曲线，0表示使用默认值X25519
+typedef enum {
+    ECDH_CURVE_DEFAULT = 0,
+    ECDH_CURVE_X25519 = 1,
+    ECDH_CURVE_P256 = 2,
+} EcdhCurve;
+
+// AES-CBC填充方式
+typedef enum {
+    AES_PADDING_PKCS7 = 0,
+    AES_PADDING_ZERO = 1,
+    AES_PADDING_NONE = 2,
+} AesPadding;
+
+// SM2密文拼接顺序
+typedef enum {
+    SM2_CIPHERTEXT_C1C3C2 = 0,
+    SM2_CIPHERTEXT_C1C2C3 = 1,
+} Sm2CiphertextOrder;
+
+// SM4-ECB/CBC填充方式
+typedef enum {
+    SM4_PADDING_PKCS7 = 0,
+    SM4_PADDING_ZERO = 1,
+    SM4_PADDING_NONE = 2,
+} Sm4Padding;
+
+// 摘要算法，0表示使用默认值SHA-256
+typedef enum {
+    HASH_ALGORITHM_DEFAULT = 0,
+    HASH_ALGORITHM_MD5 = 1,
+    HASH_ALGORITHM_SHA1 = 2,
+    HASH_ALGORITHM_SHA224 = 3,
+    HASH_ALGORITHM_SHA256 = 4,
+    HASH_ALGORITHM_SHA384 = 5,
+    HASH_ALGORITHM_SHA512 = 6,
+    HASH_ALGORITHM_SHA512_224 = 7,
+    HASH_ALGORITHM_SHA512_256 = 8,
+    HASH_ALGORITHM_SHA3_224 = 9,
+    HASH_ALGORITHM_SHA3_256 = 10,
+    HASH_ALGORITHM_SHA3_384 = 11,
+    HASH_ALGORITHM_SHA3_512 = 12,
+    HASH_ALGORITHM_BLAKE2B_256 = 13,
+    HASH_ALGORITHM_BLAKE2B_384 = 14,
+    HASH_ALGORITHM_BLAKE2B_512 = 15,
+    HASH_ALGORITHM_BLAKE2S_256 = 16,
+} HashAlgorithm;
 */
 import "C"
 import (
 	"runtime"
+	"time"
 	"unsafe"
 )
 
@@ -62,6 +201,46 @@ func freeRsaKeyPair(result *C.RsaKeyPair) {
 	}
 }
 
+// freeEcdsaKeyPair 释放为EcdsaKeyPair分配的内存
+func freeEcdsaKeyPair(result *C.EcdsaKeyPair) {
+	freeByteArray(&result.publicKey)
+	freeByteArray(&result.privateKey)
+	if result.error != nil {
+		C.free(unsafe.Pointer(result.error))
+		result.error = nil
+	}
+}
+
+// freeEd25519KeyPair 释放为Ed25519KeyPair分配的内存
+func freeEd25519KeyPair(result *C.Ed25519KeyPair) {
+	freeByteArray(&result.publicKey)
+	freeByteArray(&result.privateKey)
+	if result.error != nil {
+		C.free(unsafe.Pointer(result.error))
+		result.error = nil
+	}
+}
+
+// freeEcdhKeyPair 释放为EcdhKeyPair分配的内存
+func freeEcdhKeyPair(result *C.EcdhKeyPair) {
+	freeByteArray(&result.publicKey)
+	freeByteArray(&result.privateKey)
+	if result.error != nil {
+		C.free(unsafe.Pointer(result.error))
+		result.error = nil
+	}
+}
+
+// freeSm2KeyPair 释放为Sm2KeyPair分配的内存
+func freeSm2KeyPair(result *C.Sm2KeyPair) {
+	freeByteArray(&result.publicKey)
+	freeByteArray(&result.privateKey)
+	if result.error != nil {
+		C.free(unsafe.Pointer(result.error))
+		result.error = nil
+	}
+}
+
 // freeStringResult 释放为StringResult分配的内存
 func freeStringResult(result *C.StringResult) {
 	if result.data != nil {
@@ -82,6 +261,22 @@ func freeBoolResult(result *C.BoolResult) {
 	}
 }
 
+// freeHandleResult 释放为HandleResult分配的内存，不会释放句柄本身
+func freeHandleResult(result *C.HandleResult) {
+	if result.error != nil {
+		C.free(unsafe.Pointer(result.error))
+		result.error = nil
+	}
+}
+
+// freeIntResult 释放为IntResult分配的内存
+func freeIntResult(result *C.IntResult) {
+	if result.error != nil {
+		C.free(unsafe.Pointer(result.error))
+		result.error = nil
+	}
+}
+
 // 数据转换工具函数
 // goBytes2CByteArray 将Go字节切片转换为C ByteArray
 func goBytes2CByteArray(data []byte, err error) C.ByteArray {
@@ -156,6 +351,38 @@ func createBoolResult(success bool, err error) C.BoolResult {
 	return result
 }
 
+// createHandleResult 保存对象并将句柄和错误封装为HandleResult
+func createHandleResult(value interface{}, err error) C.HandleResult {
+	var result C.HandleResult
+
+	if err != nil {
+		result.error = C.CString(err.Error())
+		result.handle = 0
+		return result
+	}
+
+	result.handle = C.ulonglong(newHandle(value))
+	result.error = nil
+
+	return result
+}
+
+// createIntResult 将整数和错误封装为IntResult
+func createIntResult(value int64, err error) C.IntResult {
+	var result C.IntResult
+
+	if err != nil {
+		result.error = C.CString(err.Error())
+		result.value = 0
+		return result
+	}
+
+	result.value = C.longlong(value)
+	result.error = nil
+
+	return result
+}
+
 // RSA接口导出函数
 //
 //export goRsaGenKeyPair
@@ -177,6 +404,31 @@ func goRsaGenKeyPair(bits C.int) C.RsaKeyPair {
 	return result
 }
 
+//export goRsaGenKeyPairWithOptions
+func goRsaGenKeyPairWithOptions(bits C.int, privateKeyFormat C.int, publicKeyFormat C.int, encoding C.int, publicExponent C.int) C.RsaKeyPair {
+	var result C.RsaKeyPair
+
+	// 按选项生成密钥对
+	keyPair, err := RsaGenKeyPairWithOptions(&RsaKeyPairOptions{
+		KeySize:          int(bits),
+		PrivateKeyFormat: RsaPrivateKeyFormat(privateKeyFormat),
+		PublicKeyFormat:  RsaPublicKeyFormat(publicKeyFormat),
+		Encoding:         RsaKeyEncoding(encoding),
+		PublicExponent:   int(publicExponent),
+	})
+	if err != nil {
+		result.error = C.CString(err.Error())
+		return result
+	}
+
+	// 将公钥和私钥转换为C的ByteArray
+	result.publicKey = goBytes2CByteArray(keyPair.PublicKey, nil)
+	result.privateKey = goBytes2CByteArray(keyPair.PrivateKey, nil)
+	result.error = nil
+
+	return result
+}
+
 //export goRsaGetPublicKeyBase64
 func goRsaGetPublicKeyBase64(publicKey *C.byte, publicKeyLen C.int) C.StringResult {
 	// 转换C字节数组为Go切片
@@ -356,16 +608,2482 @@ func goRsaVerifySha1(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLe
 	return createBoolResult(verified, err)
 }
 
-// 内存管理函数导出
+// newRsaOaepOptions 将C参数转换为OAEP选项
+func newRsaOaepOptions(hash C.int, mgfHash C.int, label *C.byte, labelLen C.int) *RsaOaepOptions {
+	return &RsaOaepOptions{
+		Hash:    RsaHash(hash),
+		MGFHash: RsaHash(mgfHash),
+		Label:   goCBytes2GoSlice(label, labelLen),
+	}
+}
 
-//export goFreeByteArray
-func goFreeByteArray(result C.ByteArray) {
-	freeByteArray(&result)
+//export goRsaEncryptOaep
+func goRsaEncryptOaep(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, hash C.int, mgfHash C.int, label *C.byte, labelLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	opts := newRsaOaepOptions(hash, mgfHash, label, labelLen)
+
+	// 使用OAEP加密数据
+	encrypted, err := RsaEncryptOaep(dataGo, publicKeyGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
 }
 
-//export goFreeRsaKeyPair
-func goFreeRsaKeyPair(result C.RsaKeyPair) {
-	freeRsaKeyPair(&result)
+//export goRsaEncryptOaepBase64
+func goRsaEncryptOaepBase64(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, hash C.int, mgfHash C.int, label *C.byte, labelLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	opts := newRsaOaepOptions(hash, mgfHash, label, labelLen)
+
+	// 使用OAEP加密数据
+	encryptedBase64, err := RsaEncryptOaepBase64(dataGo, publicKeyGo, opts)
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goRsaDecryptOaep
+func goRsaDecryptOaep(encryptedData *C.byte, encryptedDataLen C.int, privateKey *C.byte, privateKeyLen C.int, hash C.int, mgfHash C.int, label *C.byte, labelLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	opts := newRsaOaepOptions(hash, mgfHash, label, labelLen)
+
+	// 使用OAEP解密数据
+	decrypted, err := RsaDecryptOaep(encryptedDataGo, privateKeyGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goRsaDecryptOaepFromBase64
+func goRsaDecryptOaepFromBase64(encryptedBase64 *C.char, privateKey *C.byte, privateKeyLen C.int, hash C.int, mgfHash C.int, label *C.byte, labelLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	opts := newRsaOaepOptions(hash, mgfHash, label, labelLen)
+
+	// 使用OAEP解密数据
+	decrypted, err := RsaDecryptOaepFromBase64(encryptedBase64Go, privateKeyGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+// newRsaPssOptions 将C参数转换为PSS选项
+func newRsaPssOptions(hash C.int, saltLength C.int) *RsaPssOptions {
+	return &RsaPssOptions{
+		Hash:       RsaHash(hash),
+		SaltLength: int(saltLength),
+	}
+}
+
+//export goRsaSignPss
+func goRsaSignPss(data *C.byte, dataLen C.int, privateKey *C.byte, privateKeyLen C.int, hash C.int, saltLength C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	opts := newRsaPssOptions(hash, saltLength)
+
+	// 使用PSS签名数据
+	signature, err := RsaSignPss(dataGo, privateKeyGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(signature, err)
+}
+
+//export goRsaSignPssBase64
+func goRsaSignPssBase64(data *C.char, privateKey *C.byte, privateKeyLen C.int, hash C.int, saltLength C.int) C.StringResult {
+	// 转换C字符串和C字节数组为Go类型
+	dataGo := C.GoString(data)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	opts := newRsaPssOptions(hash, saltLength)
+
+	// 使用PSS签名数据
+	signatureBase64, err := RsaSignPssBase64(dataGo, privateKeyGo, opts)
+
+	// 设置结果
+	return createStringResult(signatureBase64, err)
+}
+
+//export goRsaVerifyPss
+func goRsaVerifyPss(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, signature *C.byte, signatureLen C.int, hash C.int, saltLength C.int) C.BoolResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureGo := goCBytes2GoSlice(signature, signatureLen)
+	opts := newRsaPssOptions(hash, saltLength)
+
+	// 验证PSS签名
+	verified, err := RsaVerifyPss(dataGo, publicKeyGo, signatureGo, opts)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+//export goRsaVerifyPssFromBase64
+func goRsaVerifyPssFromBase64(data *C.char, publicKey *C.byte, publicKeyLen C.int, signatureBase64 *C.char, hash C.int, saltLength C.int) C.BoolResult {
+	// 转换C字符串和C字节数组为Go类型
+	dataGo := C.GoString(data)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureBase64Go := C.GoString(signatureBase64)
+	opts := newRsaPssOptions(hash, saltLength)
+
+	// 验证PSS签名
+	verified, err := RsaVerifyPssFromBase64(dataGo, publicKeyGo, signatureBase64Go, opts)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+// newRsaSignOptions 将C参数转换为签名选项
+func newRsaSignOptions(hash C.int, padding C.int, saltLength C.int) *RsaSignOptions {
+	return &RsaSignOptions{
+		Hash:       RsaHash(hash),
+		Padding:    RsaPadding(padding),
+		SaltLength: int(saltLength),
+	}
+}
+
+//export goRsaSignWithOptions
+func goRsaSignWithOptions(data *C.byte, dataLen C.int, privateKey *C.byte, privateKeyLen C.int, hash C.int, padding C.int, saltLength C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	opts := newRsaSignOptions(hash, padding, saltLength)
+
+	// 按指定摘要算法和填充方式签名数据
+	signature, err := RsaSignWithOptions(dataGo, privateKeyGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(signature, err)
+}
+
+//export goRsaVerifyWithOptions
+func goRsaVerifyWithOptions(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, signature *C.byte, signatureLen C.int, hash C.int, padding C.int, saltLength C.int) C.BoolResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureGo := goCBytes2GoSlice(signature, signatureLen)
+	opts := newRsaSignOptions(hash, padding, saltLength)
+
+	// 按指定摘要算法和填充方式验证签名
+	verified, err := RsaVerifyWithOptions(dataGo, publicKeyGo, signatureGo, opts)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+//export goRsaSignDigest
+func goRsaSignDigest(digest *C.byte, digestLen C.int, privateKey *C.byte, privateKeyLen C.int, hash C.int, padding C.int, saltLength C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	digestGo := goCBytes2GoSlice(digest, digestLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	opts := newRsaSignOptions(hash, padding, saltLength)
+
+	// 对摘要签名
+	signature, err := RsaSignDigest(digestGo, privateKeyGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(signature, err)
+}
+
+//export goRsaVerifyDigest
+func goRsaVerifyDigest(digest *C.byte, digestLen C.int, publicKey *C.byte, publicKeyLen C.int, signature *C.byte, signatureLen C.int, hash C.int, padding C.int, saltLength C.int) C.BoolResult {
+	// 转换C字节数组为Go切片
+	digestGo := goCBytes2GoSlice(digest, digestLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureGo := goCBytes2GoSlice(signature, signatureLen)
+	opts := newRsaSignOptions(hash, padding, saltLength)
+
+	// 验证摘要签名
+	verified, err := RsaVerifyDigest(digestGo, publicKeyGo, signatureGo, opts)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+//export goRsaEncryptSegmented
+func goRsaEncryptSegmented(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 分段加密数据
+	encrypted, err := RsaEncryptSegmented(dataGo, publicKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goRsaEncryptSegmentedBase64
+func goRsaEncryptSegmentedBase64(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 分段加密数据
+	encryptedBase64, err := RsaEncryptSegmentedBase64(dataGo, publicKeyGo)
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goRsaDecryptSegmented
+func goRsaDecryptSegmented(encryptedData *C.byte, encryptedDataLen C.int, privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 分段解密数据
+	decrypted, err := RsaDecryptSegmented(encryptedDataGo, privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goRsaDecryptSegmentedFromBase64
+func goRsaDecryptSegmentedFromBase64(encryptedBase64 *C.char, privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 分段解密数据
+	decrypted, err := RsaDecryptSegmentedFromBase64(encryptedBase64Go, privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goRsaEncryptOaepSegmented
+func goRsaEncryptOaepSegmented(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, hash C.int, mgfHash C.int, label *C.byte, labelLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	opts := newRsaOaepOptions(hash, mgfHash, label, labelLen)
+
+	// 使用OAEP分段加密数据
+	encrypted, err := RsaEncryptOaepSegmented(dataGo, publicKeyGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goRsaEncryptOaepSegmentedBase64
+func goRsaEncryptOaepSegmentedBase64(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, hash C.int, mgfHash C.int, label *C.byte, labelLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	opts := newRsaOaepOptions(hash, mgfHash, label, labelLen)
+
+	// 使用OAEP分段加密数据
+	encryptedBase64, err := RsaEncryptOaepSegmentedBase64(dataGo, publicKeyGo, opts)
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goRsaDecryptOaepSegmented
+func goRsaDecryptOaepSegmented(encryptedData *C.byte, encryptedDataLen C.int, privateKey *C.byte, privateKeyLen C.int, hash C.int, mgfHash C.int, label *C.byte, labelLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	opts := newRsaOaepOptions(hash, mgfHash, label, labelLen)
+
+	// 使用OAEP分段解密数据
+	decrypted, err := RsaDecryptOaepSegmented(encryptedDataGo, privateKeyGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goRsaDecryptOaepSegmentedFromBase64
+func goRsaDecryptOaepSegmentedFromBase64(encryptedBase64 *C.char, privateKey *C.byte, privateKeyLen C.int, hash C.int, mgfHash C.int, label *C.byte, labelLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	opts := newRsaOaepOptions(hash, mgfHash, label, labelLen)
+
+	// 使用OAEP分段解密数据
+	decrypted, err := RsaDecryptOaepSegmentedFromBase64(encryptedBase64Go, privateKeyGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goRsaPrivateKeyToPem
+func goRsaPrivateKeyToPem(privateKey *C.byte, privateKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := RsaPrivateKeyToPem(privateKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goRsaPublicKeyToPem
+func goRsaPublicKeyToPem(publicKey *C.byte, publicKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := RsaPublicKeyToPem(publicKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goRsaPemToDer
+func goRsaPemToDer(pemData *C.char) C.ByteArray {
+	// 转换C字符串为Go字符串
+	pemDataGo := C.GoString(pemData)
+
+	// 解码PEM
+	der, err := RsaPemToDer(pemDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(der, err)
+}
+
+//export goRsaConvertPkcs8ToPkcs1
+func goRsaConvertPkcs8ToPkcs1(privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 将PKCS8私钥转换为PKCS1格式
+	converted, err := RsaConvertPkcs8ToPkcs1(privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(converted, err)
+}
+
+//export goRsaConvertPkcs1ToPkcs8
+func goRsaConvertPkcs1ToPkcs8(privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 将PKCS1私钥转换为PKCS8格式
+	converted, err := RsaConvertPkcs1ToPkcs8(privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(converted, err)
+}
+
+//export goRsaConvertPublicKeyToPkcs1
+func goRsaConvertPublicKeyToPkcs1(publicKey *C.byte, publicKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 将公钥转换为PKCS1格式
+	converted, err := RsaConvertPublicKeyToPkcs1(publicKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(converted, err)
+}
+
+//export goRsaConvertPublicKeyToPkix
+func goRsaConvertPublicKeyToPkix(publicKey *C.byte, publicKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 将公钥转换为PKIX格式
+	converted, err := RsaConvertPublicKeyToPkix(publicKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(converted, err)
+}
+
+// newRsaEncryptPrivateKeyOptions 将C参数转换为私钥加密选项，0表示使用默认值
+func newRsaEncryptPrivateKeyOptions(kdf C.int, iterations C.int, scryptN C.int, scryptR C.int, scryptP C.int) *RsaEncryptPrivateKeyOptions {
+	return &RsaEncryptPrivateKeyOptions{
+		KeyDerivation: RsaKeyDerivation(kdf),
+		Iterations:    int(iterations),
+		ScryptN:       int(scryptN),
+		ScryptR:       int(scryptR),
+		ScryptP:       int(scryptP),
+	}
+}
+
+//export goRsaEncryptPrivateKey
+func goRsaEncryptPrivateKey(privateKey *C.byte, privateKeyLen C.int, password *C.byte, passwordLen C.int, kdf C.int, iterations C.int, scryptN C.int, scryptR C.int, scryptP C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	passwordGo := goCBytes2GoSlice(password, passwordLen)
+	opts := newRsaEncryptPrivateKeyOptions(kdf, iterations, scryptN, scryptR, scryptP)
+
+	// 使用密码加密私钥
+	encrypted, err := RsaEncryptPrivateKey(privateKeyGo, passwordGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goRsaEncryptPrivateKeyToPem
+func goRsaEncryptPrivateKeyToPem(privateKey *C.byte, privateKeyLen C.int, password *C.byte, passwordLen C.int, kdf C.int, iterations C.int, scryptN C.int, scryptR C.int, scryptP C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	passwordGo := goCBytes2GoSlice(password, passwordLen)
+	opts := newRsaEncryptPrivateKeyOptions(kdf, iterations, scryptN, scryptR, scryptP)
+
+	// 使用密码加密私钥并输出PEM
+	pemData, err := RsaEncryptPrivateKeyToPem(privateKeyGo, passwordGo, opts)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goRsaDecryptPrivateKey
+func goRsaDecryptPrivateKey(encryptedKey *C.byte, encryptedKeyLen C.int, password *C.byte, passwordLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedKeyGo := goCBytes2GoSlice(encryptedKey, encryptedKeyLen)
+	passwordGo := goCBytes2GoSlice(password, passwordLen)
+
+	// 使用密码解密私钥
+	privateKeyGo, err := RsaDecryptPrivateKey(encryptedKeyGo, passwordGo)
+
+	// 转换结果
+	return goBytes2CByteArray(privateKeyGo, err)
+}
+
+//export goRsaPublicKeyToOpenSsh
+func goRsaPublicKeyToOpenSsh(publicKey *C.byte, publicKeyLen C.int, comment *C.char) C.StringResult {
+	// 转换C字节数组和C字符串为Go类型
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	commentGo := C.GoString(comment)
+
+	// 转换为authorized_keys格式
+	line, err := RsaPublicKeyToOpenSsh(publicKeyGo, commentGo)
+
+	// 设置结果
+	return createStringResult(line, err)
+}
+
+//export goRsaOpenSshToPublicKey
+func goRsaOpenSshToPublicKey(authorizedKey *C.char) C.ByteArray {
+	// 转换C字符串为Go字符串
+	authorizedKeyGo := C.GoString(authorizedKey)
+
+	// 解析authorized_keys格式公钥
+	publicKey, err := RsaOpenSshToPublicKey(authorizedKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(publicKey, err)
+}
+
+//export goRsaPrivateKeyToOpenSsh
+func goRsaPrivateKeyToOpenSsh(privateKey *C.byte, privateKeyLen C.int, passphrase *C.byte, passphraseLen C.int, comment *C.char) C.StringResult {
+	// 转换C字节数组和C字符串为Go类型
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	passphraseGo := goCBytes2GoSlice(passphrase, passphraseLen)
+	commentGo := C.GoString(comment)
+
+	// 转换为OpenSSH私钥格式
+	pemData, err := RsaPrivateKeyToOpenSsh(privateKeyGo, passphraseGo, commentGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goRsaOpenSshToPrivateKey
+func goRsaOpenSshToPrivateKey(pemData *C.char, passphrase *C.byte, passphraseLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	pemDataGo := C.GoString(pemData)
+	passphraseGo := goCBytes2GoSlice(passphrase, passphraseLen)
+
+	// 解析OpenSSH私钥
+	privateKey, err := RsaOpenSshToPrivateKey(pemDataGo, passphraseGo)
+
+	// 转换结果
+	return goBytes2CByteArray(privateKey, err)
+}
+
+//export goRsaOpenSshFingerprint
+func goRsaOpenSshFingerprint(publicKey *C.byte, publicKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 计算SHA256指纹
+	fingerprint, err := RsaOpenSshFingerprint(publicKeyGo)
+
+	// 设置结果
+	return createStringResult(fingerprint, err)
+}
+
+// 信封加密接口导出函数
+//
+//export goEnvelopeSeal
+func goEnvelopeSeal(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 信封加密数据
+	sealed, err := EnvelopeSeal(dataGo, publicKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(sealed, err)
+}
+
+//export goEnvelopeSealBase64
+func goEnvelopeSealBase64(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 信封加密数据
+	sealedBase64, err := EnvelopeSealBase64(dataGo, publicKeyGo)
+
+	// 设置结果
+	return createStringResult(sealedBase64, err)
+}
+
+//export goEnvelopeOpen
+func goEnvelopeOpen(sealed *C.byte, sealedLen C.int, privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	sealedGo := goCBytes2GoSlice(sealed, sealedLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 解密信封
+	opened, err := EnvelopeOpen(sealedGo, privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(opened, err)
+}
+
+//export goEnvelopeOpenFromBase64
+func goEnvelopeOpenFromBase64(sealedBase64 *C.char, privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	sealedBase64Go := C.GoString(sealedBase64)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 解密信封
+	opened, err := EnvelopeOpenFromBase64(sealedBase64Go, privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(opened, err)
+}
+
+// ECDSA接口导出函数
+//
+//export goEcdsaGenKeyPair
+func goEcdsaGenKeyPair(curve C.int) C.EcdsaKeyPair {
+	var result C.EcdsaKeyPair
+
+	// 生成密钥对
+	keyPair, err := EcdsaGenKeyPair(EcdsaCurve(curve))
+	if err != nil {
+		result.error = C.CString(err.Error())
+		return result
+	}
+
+	// 将公钥和私钥转换为C的ByteArray
+	result.publicKey = goBytes2CByteArray(keyPair.PublicKey, nil)
+	result.privateKey = goBytes2CByteArray(keyPair.PrivateKey, nil)
+	result.error = nil
+
+	return result
+}
+
+//export goEcdsaExtractPublicKey
+func goEcdsaExtractPublicKey(privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 提取公钥
+	publicKey, err := EcdsaExtractPublicKey(privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(publicKey, err)
+}
+
+//export goEcdsaSign
+func goEcdsaSign(data *C.byte, dataLen C.int, privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 签名数据
+	signature, err := EcdsaSign(dataGo, privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(signature, err)
+}
+
+//export goEcdsaSignBase64
+func goEcdsaSignBase64(data *C.char, privateKey *C.byte, privateKeyLen C.int) C.StringResult {
+	// 转换C字符串和C字节数组为Go类型
+	dataGo := C.GoString(data)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 签名数据
+	signatureBase64, err := EcdsaSignBase64(dataGo, privateKeyGo)
+
+	// 设置结果
+	return createStringResult(signatureBase64, err)
+}
+
+//export goEcdsaSignRaw
+func goEcdsaSignRaw(data *C.byte, dataLen C.int, privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 签名数据，输出r||s格式
+	signature, err := EcdsaSignRaw(dataGo, privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(signature, err)
+}
+
+//export goEcdsaVerify
+func goEcdsaVerify(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, signature *C.byte, signatureLen C.int) C.BoolResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureGo := goCBytes2GoSlice(signature, signatureLen)
+
+	// 验证签名
+	verified, err := EcdsaVerify(dataGo, publicKeyGo, signatureGo)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+//export goEcdsaVerifyFromBase64
+func goEcdsaVerifyFromBase64(data *C.char, publicKey *C.byte, publicKeyLen C.int, signatureBase64 *C.char) C.BoolResult {
+	// 转换C字符串和C字节数组为Go类型
+	dataGo := C.GoString(data)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureBase64Go := C.GoString(signatureBase64)
+
+	// 验证签名
+	verified, err := EcdsaVerifyFromBase64(dataGo, publicKeyGo, signatureBase64Go)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+//export goEcdsaVerifyRaw
+func goEcdsaVerifyRaw(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, signature *C.byte, signatureLen C.int) C.BoolResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureGo := goCBytes2GoSlice(signature, signatureLen)
+
+	// 验证r||s格式签名
+	verified, err := EcdsaVerifyRaw(dataGo, publicKeyGo, signatureGo)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+// curve 必须显式指定，ECDSA_CURVE_DEFAULT 会返回错误
+//
+//export goEcdsaDerToRawSignature
+func goEcdsaDerToRawSignature(signature *C.byte, signatureLen C.int, curve C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	signatureGo := goCBytes2GoSlice(signature, signatureLen)
+
+	// DER签名转换为r||s格式
+	raw, err := EcdsaDerToRawSignature(signatureGo, EcdsaCurve(curve))
+
+	// 转换结果
+	return goBytes2CByteArray(raw, err)
+}
+
+//export goEcdsaRawToDerSignature
+func goEcdsaRawToDerSignature(signature *C.byte, signatureLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	signatureGo := goCBytes2GoSlice(signature, signatureLen)
+
+	// r||s签名转换为DER格式
+	der, err := EcdsaRawToDerSignature(signatureGo)
+
+	// 转换结果
+	return goBytes2CByteArray(der, err)
+}
+
+//export goEcdsaConvertPkcs8ToSec1
+func goEcdsaConvertPkcs8ToSec1(privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 将PKCS8私钥转换为SEC1格式
+	converted, err := EcdsaConvertPkcs8ToSec1(privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(converted, err)
+}
+
+//export goEcdsaConvertSec1ToPkcs8
+func goEcdsaConvertSec1ToPkcs8(privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 将SEC1私钥转换为PKCS8格式
+	converted, err := EcdsaConvertSec1ToPkcs8(privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(converted, err)
+}
+
+//export goEcdsaPrivateKeyToPem
+func goEcdsaPrivateKeyToPem(privateKey *C.byte, privateKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := EcdsaPrivateKeyToPem(privateKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goEcdsaPublicKeyToPem
+func goEcdsaPublicKeyToPem(publicKey *C.byte, publicKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := EcdsaPublicKeyToPem(publicKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+// Ed25519接口导出函数
+//
+//export goEd25519GenKeyPair
+func goEd25519GenKeyPair() C.Ed25519KeyPair {
+	var result C.Ed25519KeyPair
+
+	// 生成密钥对
+	keyPair, err := Ed25519GenKeyPair()
+	if err != nil {
+		result.error = C.CString(err.Error())
+		return result
+	}
+
+	// 将公钥和私钥转换为C的ByteArray
+	result.publicKey = goBytes2CByteArray(keyPair.PublicKey, nil)
+	result.privateKey = goBytes2CByteArray(keyPair.PrivateKey, nil)
+	result.error = nil
+
+	return result
+}
+
+//export goEd25519GenKeyPairFromSeed
+func goEd25519GenKeyPairFromSeed(seed *C.byte, seedLen C.int) C.Ed25519KeyPair {
+	var result C.Ed25519KeyPair
+
+	// 转换C字节数组为Go切片
+	seedGo := goCBytes2GoSlice(seed, seedLen)
+
+	// 从种子生成密钥对
+	keyPair, err := Ed25519GenKeyPairFromSeed(seedGo)
+	if err != nil {
+		result.error = C.CString(err.Error())
+		return result
+	}
+
+	// 将公钥和私钥转换为C的ByteArray
+	result.publicKey = goBytes2CByteArray(keyPair.PublicKey, nil)
+	result.privateKey = goBytes2CByteArray(keyPair.PrivateKey, nil)
+	result.error = nil
+
+	return result
+}
+
+//export goEd25519ExtractPublicKey
+func goEd25519ExtractPublicKey(privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 提取公钥
+	publicKey, err := Ed25519ExtractPublicKey(privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(publicKey, err)
+}
+
+//export goEd25519PrivateKeyToSeed
+func goEd25519PrivateKeyToSeed(privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 提取32字节种子
+	seed, err := Ed25519PrivateKeyToSeed(privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(seed, err)
+}
+
+//export goEd25519PublicKeyToRaw
+func goEd25519PublicKeyToRaw(publicKey *C.byte, publicKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 转换为32字节原始公钥
+	raw, err := Ed25519PublicKeyToRaw(publicKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(raw, err)
+}
+
+//export goEd25519RawToPublicKey
+func goEd25519RawToPublicKey(raw *C.byte, rawLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	rawGo := goCBytes2GoSlice(raw, rawLen)
+
+	// 转换为PKIX公钥
+	publicKey, err := Ed25519RawToPublicKey(rawGo)
+
+	// 转换结果
+	return goBytes2CByteArray(publicKey, err)
+}
+
+//export goEd25519PrivateKeyToPem
+func goEd25519PrivateKeyToPem(privateKey *C.byte, privateKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := Ed25519PrivateKeyToPem(privateKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goEd25519PublicKeyToPem
+func goEd25519PublicKeyToPem(publicKey *C.byte, publicKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := Ed25519PublicKeyToPem(publicKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goEd25519Sign
+func goEd25519Sign(data *C.byte, dataLen C.int, privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 签名数据
+	signature, err := Ed25519Sign(dataGo, privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(signature, err)
+}
+
+//export goEd25519SignBase64
+func goEd25519SignBase64(data *C.char, privateKey *C.byte, privateKeyLen C.int) C.StringResult {
+	// 转换C字符串和C字节数组为Go类型
+	dataGo := C.GoString(data)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 签名数据
+	signatureBase64, err := Ed25519SignBase64(dataGo, privateKeyGo)
+
+	// 设置结果
+	return createStringResult(signatureBase64, err)
+}
+
+//export goEd25519Verify
+func goEd25519Verify(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, signature *C.byte, signatureLen C.int) C.BoolResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureGo := goCBytes2GoSlice(signature, signatureLen)
+
+	// 验证签名
+	verified, err := Ed25519Verify(dataGo, publicKeyGo, signatureGo)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+//export goEd25519VerifyFromBase64
+func goEd25519VerifyFromBase64(data *C.char, publicKey *C.byte, publicKeyLen C.int, signatureBase64 *C.char) C.BoolResult {
+	// 转换C字符串和C字节数组为Go类型
+	dataGo := C.GoString(data)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureBase64Go := C.GoString(signatureBase64)
+
+	// 验证签名
+	verified, err := Ed25519VerifyFromBase64(dataGo, publicKeyGo, signatureBase64Go)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+// ECDH接口导出函数
+//
+//export goEcdhGenKeyPair
+func goEcdhGenKeyPair(curve C.int) C.EcdhKeyPair {
+	var result C.EcdhKeyPair
+
+	// 生成密钥对
+	keyPair, err := EcdhGenKeyPair(EcdhCurve(curve))
+	if err != nil {
+		result.error = C.CString(err.Error())
+		return result
+	}
+
+	// 将公钥和私钥转换为C的ByteArray
+	result.publicKey = goBytes2CByteArray(keyPair.PublicKey, nil)
+	result.privateKey = goBytes2CByteArray(keyPair.PrivateKey, nil)
+	result.error = nil
+
+	return result
+}
+
+//export goEcdhExtractPublicKey
+func goEcdhExtractPublicKey(privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 提取公钥
+	publicKey, err := EcdhExtractPublicKey(privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(publicKey, err)
+}
+
+//export goEcdhPublicKeyToRaw
+func goEcdhPublicKeyToRaw(publicKey *C.byte, publicKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 转换为原始公钥
+	raw, err := EcdhPublicKeyToRaw(publicKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(raw, err)
+}
+
+//export goEcdhRawToPublicKey
+func goEcdhRawToPublicKey(raw *C.byte, rawLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	rawGo := goCBytes2GoSlice(raw, rawLen)
+
+	// 转换为PKIX公钥
+	publicKey, err := EcdhRawToPublicKey(rawGo)
+
+	// 转换结果
+	return goBytes2CByteArray(publicKey, err)
+}
+
+//export goEcdhPrivateKeyToPem
+func goEcdhPrivateKeyToPem(privateKey *C.byte, privateKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := EcdhPrivateKeyToPem(privateKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goEcdhPublicKeyToPem
+func goEcdhPublicKeyToPem(publicKey *C.byte, publicKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := EcdhPublicKeyToPem(publicKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goEcdhSharedSecret
+func goEcdhSharedSecret(privateKey *C.byte, privateKeyLen C.int, peerPublicKey *C.byte, peerPublicKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	peerPublicKeyGo := goCBytes2GoSlice(peerPublicKey, peerPublicKeyLen)
+
+	// 计算共享密钥
+	sharedSecret, err := EcdhSharedSecret(privateKeyGo, peerPublicKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(sharedSecret, err)
+}
+
+//export goEcdhDeriveKey
+func goEcdhDeriveKey(sharedSecret *C.byte, sharedSecretLen C.int, salt *C.byte, saltLen C.int, info *C.byte, infoLen C.int, length C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	sharedSecretGo := goCBytes2GoSlice(sharedSecret, sharedSecretLen)
+	saltGo := goCBytes2GoSlice(salt, saltLen)
+	infoGo := goCBytes2GoSlice(info, infoLen)
+
+	// 使用HKDF-SHA256派生密钥
+	key, err := EcdhDeriveKey(sharedSecretGo, saltGo, infoGo, int(length))
+
+	// 转换结果
+	return goBytes2CByteArray(key, err)
+}
+
+//export goEcdhDeriveSessionKey
+func goEcdhDeriveSessionKey(privateKey *C.byte, privateKeyLen C.int, peerPublicKey *C.byte, peerPublicKeyLen C.int, salt *C.byte, saltLen C.int, info *C.byte, infoLen C.int, length C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	peerPublicKeyGo := goCBytes2GoSlice(peerPublicKey, peerPublicKeyLen)
+	saltGo := goCBytes2GoSlice(salt, saltLen)
+	infoGo := goCBytes2GoSlice(info, infoLen)
+
+	// 计算共享密钥并派生会话密钥
+	key, err := EcdhDeriveSessionKey(privateKeyGo, peerPublicKeyGo, saltGo, infoGo, int(length))
+
+	// 转换结果
+	return goBytes2CByteArray(key, err)
+}
+
+// AES接口导出函数
+//
+//export goAesGenKey
+func goAesGenKey(keySize C.int) C.ByteArray {
+	// 生成随机密钥
+	key, err := AesGenKey(int(keySize))
+
+	// 转换结果
+	return goBytes2CByteArray(key, err)
+}
+
+//export goAesEncryptGcm
+func goAesEncryptGcm(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 加密数据
+	encrypted, err := AesEncryptGcm(dataGo, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goAesEncryptGcmBase64
+func goAesEncryptGcmBase64(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 加密数据
+	encryptedBase64, err := AesEncryptGcmBase64(dataGo, keyGo, additionalDataGo)
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goAesDecryptGcm
+func goAesDecryptGcm(encryptedData *C.byte, encryptedDataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 解密数据
+	decrypted, err := AesDecryptGcm(encryptedDataGo, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goAesDecryptGcmFromBase64
+func goAesDecryptGcmFromBase64(encryptedBase64 *C.char, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 解密数据
+	decrypted, err := AesDecryptGcmFromBase64(encryptedBase64Go, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goAesGenIv
+func goAesGenIv() C.ByteArray {
+	// 生成随机IV
+	iv, err := AesGenIv()
+
+	// 转换结果
+	return goBytes2CByteArray(iv, err)
+}
+
+//export goAesEncryptCbc
+func goAesEncryptCbc(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 加密数据
+	encrypted, err := AesEncryptCbc(dataGo, keyGo, ivGo, AesPadding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goAesEncryptCbcBase64
+func goAesEncryptCbcBase64(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 加密数据
+	encryptedBase64, err := AesEncryptCbcBase64(dataGo, keyGo, ivGo, AesPadding(padding))
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goAesEncryptCbcHex
+func goAesEncryptCbcHex(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 加密数据
+	encryptedHex, err := AesEncryptCbcHex(dataGo, keyGo, ivGo, AesPadding(padding))
+
+	// 设置结果
+	return createStringResult(encryptedHex, err)
+}
+
+//export goAesDecryptCbc
+func goAesDecryptCbc(encryptedData *C.byte, encryptedDataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 解密数据
+	decrypted, err := AesDecryptCbc(encryptedDataGo, keyGo, ivGo, AesPadding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goAesDecryptCbcFromBase64
+func goAesDecryptCbcFromBase64(encryptedBase64 *C.char, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 解密数据
+	decrypted, err := AesDecryptCbcFromBase64(encryptedBase64Go, keyGo, ivGo, AesPadding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goAesDecryptCbcFromHex
+func goAesDecryptCbcFromHex(encryptedHex *C.char, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedHexGo := C.GoString(encryptedHex)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 解密数据
+	decrypted, err := AesDecryptCbcFromHex(encryptedHexGo, keyGo, ivGo, AesPadding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goAesEncryptCtr
+func goAesEncryptCtr(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 加密数据
+	encrypted, err := AesEncryptCtr(dataGo, keyGo, ivGo)
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goAesEncryptCtrBase64
+func goAesEncryptCtrBase64(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 加密数据
+	encryptedBase64, err := AesEncryptCtrBase64(dataGo, keyGo, ivGo)
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goAesEncryptCtrHex
+func goAesEncryptCtrHex(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 加密数据
+	encryptedHex, err := AesEncryptCtrHex(dataGo, keyGo, ivGo)
+
+	// 设置结果
+	return createStringResult(encryptedHex, err)
+}
+
+//export goAesDecryptCtr
+func goAesDecryptCtr(encryptedData *C.byte, encryptedDataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 解密数据
+	decrypted, err := AesDecryptCtr(encryptedDataGo, keyGo, ivGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goAesDecryptCtrFromBase64
+func goAesDecryptCtrFromBase64(encryptedBase64 *C.char, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 解密数据
+	decrypted, err := AesDecryptCtrFromBase64(encryptedBase64Go, keyGo, ivGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goAesDecryptCtrFromHex
+func goAesDecryptCtrFromHex(encryptedHex *C.char, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedHexGo := C.GoString(encryptedHex)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 解密数据
+	decrypted, err := AesDecryptCtrFromHex(encryptedHexGo, keyGo, ivGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+// ChaCha20-Poly1305接口导出函数
+//
+//export goChaCha20Poly1305GenKey
+func goChaCha20Poly1305GenKey() C.ByteArray {
+	// 生成随机密钥
+	key, err := ChaCha20Poly1305GenKey()
+
+	// 转换结果
+	return goBytes2CByteArray(key, err)
+}
+
+//export goChaCha20Poly1305Encrypt
+func goChaCha20Poly1305Encrypt(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 加密数据
+	encrypted, err := ChaCha20Poly1305Encrypt(dataGo, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goChaCha20Poly1305EncryptBase64
+func goChaCha20Poly1305EncryptBase64(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 加密数据
+	encryptedBase64, err := ChaCha20Poly1305EncryptBase64(dataGo, keyGo, additionalDataGo)
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goChaCha20Poly1305Decrypt
+func goChaCha20Poly1305Decrypt(encryptedData *C.byte, encryptedDataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 解密数据
+	decrypted, err := ChaCha20Poly1305Decrypt(encryptedDataGo, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goChaCha20Poly1305DecryptFromBase64
+func goChaCha20Poly1305DecryptFromBase64(encryptedBase64 *C.char, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 解密数据
+	decrypted, err := ChaCha20Poly1305DecryptFromBase64(encryptedBase64Go, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goXChaCha20Poly1305Encrypt
+func goXChaCha20Poly1305Encrypt(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 加密数据
+	encrypted, err := XChaCha20Poly1305Encrypt(dataGo, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goXChaCha20Poly1305EncryptBase64
+func goXChaCha20Poly1305EncryptBase64(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 加密数据
+	encryptedBase64, err := XChaCha20Poly1305EncryptBase64(dataGo, keyGo, additionalDataGo)
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goXChaCha20Poly1305Decrypt
+func goXChaCha20Poly1305Decrypt(encryptedData *C.byte, encryptedDataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 解密数据
+	decrypted, err := XChaCha20Poly1305Decrypt(encryptedDataGo, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goXChaCha20Poly1305DecryptFromBase64
+func goXChaCha20Poly1305DecryptFromBase64(encryptedBase64 *C.char, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 解密数据
+	decrypted, err := XChaCha20Poly1305DecryptFromBase64(encryptedBase64Go, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+// SM2接口导出函数
+//
+//export goSm2GenKeyPair
+func goSm2GenKeyPair() C.Sm2KeyPair {
+	var result C.Sm2KeyPair
+
+	// 生成密钥对
+	keyPair, err := Sm2GenKeyPair()
+	if err != nil {
+		result.error = C.CString(err.Error())
+		return result
+	}
+
+	// 将公钥和私钥转换为C的ByteArray
+	result.publicKey = goBytes2CByteArray(keyPair.PublicKey, nil)
+	result.privateKey = goBytes2CByteArray(keyPair.PrivateKey, nil)
+	result.error = nil
+
+	return result
+}
+
+//export goSm2ExtractPublicKey
+func goSm2ExtractPublicKey(privateKey *C.byte, privateKeyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 提取公钥
+	publicKey, err := Sm2ExtractPublicKey(privateKeyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(publicKey, err)
+}
+
+//export goSm2PrivateKeyToPem
+func goSm2PrivateKeyToPem(privateKey *C.byte, privateKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := Sm2PrivateKeyToPem(privateKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goSm2PublicKeyToPem
+func goSm2PublicKeyToPem(publicKey *C.byte, publicKeyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 转换为PEM格式
+	pemData, err := Sm2PublicKeyToPem(publicKeyGo)
+
+	// 设置结果
+	return createStringResult(pemData, err)
+}
+
+//export goSm2Sign
+func goSm2Sign(data *C.byte, dataLen C.int, privateKey *C.byte, privateKeyLen C.int, uid *C.byte, uidLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	uidGo := goCBytes2GoSlice(uid, uidLen)
+
+	// 签名数据
+	signature, err := Sm2Sign(dataGo, privateKeyGo, uidGo)
+
+	// 转换结果
+	return goBytes2CByteArray(signature, err)
+}
+
+//export goSm2SignBase64
+func goSm2SignBase64(data *C.char, privateKey *C.byte, privateKeyLen C.int, uid *C.byte, uidLen C.int) C.StringResult {
+	// 转换C字符串和C字节数组为Go类型
+	dataGo := C.GoString(data)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	uidGo := goCBytes2GoSlice(uid, uidLen)
+
+	// 签名数据
+	signatureBase64, err := Sm2SignBase64(dataGo, privateKeyGo, uidGo)
+
+	// 设置结果
+	return createStringResult(signatureBase64, err)
+}
+
+//export goSm2Verify
+func goSm2Verify(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, signature *C.byte, signatureLen C.int, uid *C.byte, uidLen C.int) C.BoolResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureGo := goCBytes2GoSlice(signature, signatureLen)
+	uidGo := goCBytes2GoSlice(uid, uidLen)
+
+	// 验证签名
+	verified, err := Sm2Verify(dataGo, publicKeyGo, signatureGo, uidGo)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+//export goSm2VerifyFromBase64
+func goSm2VerifyFromBase64(data *C.char, publicKey *C.byte, publicKeyLen C.int, signatureBase64 *C.char, uid *C.byte, uidLen C.int) C.BoolResult {
+	// 转换C字符串和C字节数组为Go类型
+	dataGo := C.GoString(data)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	signatureBase64Go := C.GoString(signatureBase64)
+	uidGo := goCBytes2GoSlice(uid, uidLen)
+
+	// 验证签名
+	verified, err := Sm2VerifyFromBase64(dataGo, publicKeyGo, signatureBase64Go, uidGo)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+//export goSm2Encrypt
+func goSm2Encrypt(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, order C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 加密数据
+	encrypted, err := Sm2Encrypt(dataGo, publicKeyGo, Sm2CiphertextOrder(order))
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goSm2EncryptBase64
+func goSm2EncryptBase64(data *C.byte, dataLen C.int, publicKey *C.byte, publicKeyLen C.int, order C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// 加密数据
+	encryptedBase64, err := Sm2EncryptBase64(dataGo, publicKeyGo, Sm2CiphertextOrder(order))
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goSm2Decrypt
+func goSm2Decrypt(encryptedData *C.byte, encryptedDataLen C.int, privateKey *C.byte, privateKeyLen C.int, order C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 解密数据
+	decrypted, err := Sm2Decrypt(encryptedDataGo, privateKeyGo, Sm2CiphertextOrder(order))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goSm2DecryptFromBase64
+func goSm2DecryptFromBase64(encryptedBase64 *C.char, privateKey *C.byte, privateKeyLen C.int, order C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// 解密数据
+	decrypted, err := Sm2DecryptFromBase64(encryptedBase64Go, privateKeyGo, Sm2CiphertextOrder(order))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+// SM3接口导出函数
+//
+//export goSm3Sum
+func goSm3Sum(data *C.byte, dataLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+
+	// 计算摘要
+	sum := Sm3Sum(dataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(sum, nil)
+}
+
+//export goSm3SumHex
+func goSm3SumHex(data *C.byte, dataLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+
+	// 计算摘要
+	sumHex := Sm3SumHex(dataGo)
+
+	// 设置结果
+	return createStringResult(sumHex, nil)
+}
+
+//export goSm3Hmac
+func goSm3Hmac(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 计算HMAC
+	mac := Sm3Hmac(dataGo, keyGo)
+
+	// 转换结果
+	return goBytes2CByteArray(mac, nil)
+}
+
+//export goSm3HmacHex
+func goSm3HmacHex(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 计算HMAC
+	macHex := Sm3HmacHex(dataGo, keyGo)
+
+	// 设置结果
+	return createStringResult(macHex, nil)
+}
+
+//export goSm3VerifyHmac
+func goSm3VerifyHmac(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, mac *C.byte, macLen C.int) C.BoolResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	macGo := goCBytes2GoSlice(mac, macLen)
+
+	// 验证HMAC
+	verified := Sm3VerifyHmac(dataGo, keyGo, macGo)
+
+	// 设置结果
+	return createBoolResult(verified, nil)
+}
+
+// SM4接口导出函数
+//
+//export goSm4GenKey
+func goSm4GenKey() C.ByteArray {
+	// 生成随机密钥
+	key, err := Sm4GenKey()
+
+	// 转换结果
+	return goBytes2CByteArray(key, err)
+}
+
+//export goSm4GenIv
+func goSm4GenIv() C.ByteArray {
+	// 生成随机IV
+	iv, err := Sm4GenIv()
+
+	// 转换结果
+	return goBytes2CByteArray(iv, err)
+}
+
+//export goSm4EncryptEcb
+func goSm4EncryptEcb(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, padding C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 加密数据
+	encrypted, err := Sm4EncryptEcb(dataGo, keyGo, Sm4Padding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goSm4EncryptEcbBase64
+func goSm4EncryptEcbBase64(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, padding C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 加密数据
+	encryptedBase64, err := Sm4EncryptEcbBase64(dataGo, keyGo, Sm4Padding(padding))
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goSm4EncryptEcbHex
+func goSm4EncryptEcbHex(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, padding C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 加密数据
+	encryptedHex, err := Sm4EncryptEcbHex(dataGo, keyGo, Sm4Padding(padding))
+
+	// 设置结果
+	return createStringResult(encryptedHex, err)
+}
+
+//export goSm4DecryptEcb
+func goSm4DecryptEcb(encryptedData *C.byte, encryptedDataLen C.int, key *C.byte, keyLen C.int, padding C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 解密数据
+	decrypted, err := Sm4DecryptEcb(encryptedDataGo, keyGo, Sm4Padding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goSm4DecryptEcbFromBase64
+func goSm4DecryptEcbFromBase64(encryptedBase64 *C.char, key *C.byte, keyLen C.int, padding C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 解密数据
+	decrypted, err := Sm4DecryptEcbFromBase64(encryptedBase64Go, keyGo, Sm4Padding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goSm4DecryptEcbFromHex
+func goSm4DecryptEcbFromHex(encryptedHex *C.char, key *C.byte, keyLen C.int, padding C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedHexGo := C.GoString(encryptedHex)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 解密数据
+	decrypted, err := Sm4DecryptEcbFromHex(encryptedHexGo, keyGo, Sm4Padding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goSm4EncryptCbc
+func goSm4EncryptCbc(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 加密数据
+	encrypted, err := Sm4EncryptCbc(dataGo, keyGo, ivGo, Sm4Padding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goSm4EncryptCbcBase64
+func goSm4EncryptCbcBase64(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 加密数据
+	encryptedBase64, err := Sm4EncryptCbcBase64(dataGo, keyGo, ivGo, Sm4Padding(padding))
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goSm4EncryptCbcHex
+func goSm4EncryptCbcHex(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 加密数据
+	encryptedHex, err := Sm4EncryptCbcHex(dataGo, keyGo, ivGo, Sm4Padding(padding))
+
+	// 设置结果
+	return createStringResult(encryptedHex, err)
+}
+
+//export goSm4DecryptCbc
+func goSm4DecryptCbc(encryptedData *C.byte, encryptedDataLen C.int, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 解密数据
+	decrypted, err := Sm4DecryptCbc(encryptedDataGo, keyGo, ivGo, Sm4Padding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goSm4DecryptCbcFromBase64
+func goSm4DecryptCbcFromBase64(encryptedBase64 *C.char, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 解密数据
+	decrypted, err := Sm4DecryptCbcFromBase64(encryptedBase64Go, keyGo, ivGo, Sm4Padding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goSm4DecryptCbcFromHex
+func goSm4DecryptCbcFromHex(encryptedHex *C.char, key *C.byte, keyLen C.int, iv *C.byte, ivLen C.int, padding C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedHexGo := C.GoString(encryptedHex)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	ivGo := goCBytes2GoSlice(iv, ivLen)
+
+	// 解密数据
+	decrypted, err := Sm4DecryptCbcFromHex(encryptedHexGo, keyGo, ivGo, Sm4Padding(padding))
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goSm4EncryptGcm
+func goSm4EncryptGcm(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 加密数据
+	encrypted, err := Sm4EncryptGcm(dataGo, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(encrypted, err)
+}
+
+//export goSm4EncryptGcmBase64
+func goSm4EncryptGcmBase64(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 加密数据
+	encryptedBase64, err := Sm4EncryptGcmBase64(dataGo, keyGo, additionalDataGo)
+
+	// 设置结果
+	return createStringResult(encryptedBase64, err)
+}
+
+//export goSm4DecryptGcm
+func goSm4DecryptGcm(encryptedData *C.byte, encryptedDataLen C.int, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	encryptedDataGo := goCBytes2GoSlice(encryptedData, encryptedDataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 解密数据
+	decrypted, err := Sm4DecryptGcm(encryptedDataGo, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+//export goSm4DecryptGcmFromBase64
+func goSm4DecryptGcmFromBase64(encryptedBase64 *C.char, key *C.byte, keyLen C.int, additionalData *C.byte, additionalDataLen C.int) C.ByteArray {
+	// 转换C字符串和C字节数组为Go类型
+	encryptedBase64Go := C.GoString(encryptedBase64)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	additionalDataGo := goCBytes2GoSlice(additionalData, additionalDataLen)
+
+	// 解密数据
+	decrypted, err := Sm4DecryptGcmFromBase64(encryptedBase64Go, keyGo, additionalDataGo)
+
+	// 转换结果
+	return goBytes2CByteArray(decrypted, err)
+}
+
+// 摘要接口导出函数
+//
+//export goHashSum
+func goHashSum(data *C.byte, dataLen C.int, algorithm C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+
+	// 计算摘要
+	digest, err := HashSum(dataGo, HashAlgorithm(algorithm))
+
+	// 转换结果
+	return goBytes2CByteArray(digest, err)
+}
+
+//export goHashSumHex
+func goHashSumHex(data *C.byte, dataLen C.int, algorithm C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+
+	// 计算摘要
+	digestHex, err := HashSumHex(dataGo, HashAlgorithm(algorithm))
+
+	// 设置结果
+	return createStringResult(digestHex, err)
+}
+
+//export goHashSumBase64
+func goHashSumBase64(data *C.byte, dataLen C.int, algorithm C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+
+	// 计算摘要
+	digestBase64, err := HashSumBase64(dataGo, HashAlgorithm(algorithm))
+
+	// 设置结果
+	return createStringResult(digestBase64, err)
+}
+
+//export goHashHmac
+func goHashHmac(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, algorithm C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 计算HMAC
+	mac, err := HashHmac(dataGo, keyGo, HashAlgorithm(algorithm))
+
+	// 转换结果
+	return goBytes2CByteArray(mac, err)
+}
+
+//export goHashHmacHex
+func goHashHmacHex(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, algorithm C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 计算HMAC
+	macHex, err := HashHmacHex(dataGo, keyGo, HashAlgorithm(algorithm))
+
+	// 设置结果
+	return createStringResult(macHex, err)
+}
+
+//export goHashHmacBase64
+func goHashHmacBase64(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, algorithm C.int) C.StringResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 计算HMAC
+	macBase64, err := HashHmacBase64(dataGo, keyGo, HashAlgorithm(algorithm))
+
+	// 设置结果
+	return createStringResult(macBase64, err)
+}
+
+//export goHashVerifyHmac
+func goHashVerifyHmac(data *C.byte, dataLen C.int, key *C.byte, keyLen C.int, mac *C.byte, macLen C.int, algorithm C.int) C.BoolResult {
+	// 转换C字节数组为Go切片
+	dataGo := goCBytes2GoSlice(data, dataLen)
+	keyGo := goCBytes2GoSlice(key, keyLen)
+	macGo := goCBytes2GoSlice(mac, macLen)
+
+	// 验证HMAC
+	verified, err := HashVerifyHmac(dataGo, keyGo, macGo, HashAlgorithm(algorithm))
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+// 流式摘要接口导出函数
+//
+//export goHashCreate
+func goHashCreate(algorithm C.int) C.HandleResult {
+	// 创建摘要计算器
+	hasher, err := HashNew(HashAlgorithm(algorithm))
+
+	// 保存句柄
+	return createHandleResult(hasher, err)
+}
+
+//export goHashCreateHmac
+func goHashCreateHmac(key *C.byte, keyLen C.int, algorithm C.int) C.HandleResult {
+	// 转换C字节数组为Go切片
+	keyGo := goCBytes2GoSlice(key, keyLen)
+
+	// 创建HMAC计算器
+	hasher, err := HashNewHmac(keyGo, HashAlgorithm(algorithm))
+
+	// 保存句柄
+	return createHandleResult(hasher, err)
+}
+
+//export goHashUpdate
+func goHashUpdate(handle C.ulonglong, data *C.byte, dataLen C.int) C.BoolResult {
+	hasher, err := loadHandle[*HashHasher](uint64(handle))
+	if err != nil {
+		return createBoolResult(false, err)
+	}
+
+	// 追加数据
+	hasher.Update(goCBytes2GoSlice(data, dataLen))
+
+	// 设置结果
+	return createBoolResult(true, nil)
+}
+
+//export goHashFinish
+func goHashFinish(handle C.ulonglong) C.ByteArray {
+	hasher, err := loadHandle[*HashHasher](uint64(handle))
+	if err != nil {
+		return goBytes2CByteArray(nil, err)
+	}
+
+	// 转换结果，句柄需调用goHashFree释放
+	return goBytes2CByteArray(hasher.Final(), nil)
+}
+
+//export goHashFree
+func goHashFree(handle C.ulonglong) C.BoolResult {
+	// 释放句柄，句柄类型不符时返回错误
+	err := freeHandle[*HashHasher](uint64(handle))
+
+	// 设置结果
+	return createBoolResult(err == nil, err)
+}
+
+// 流式RSA签名接口导出函数
+//
+//export goRsaSignCreate
+func goRsaSignCreate(privateKey *C.byte, privateKeyLen C.int, hash C.int, padding C.int, saltLength C.int) C.HandleResult {
+	// 转换C字节数组为Go切片
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	opts := newRsaSignOptions(hash, padding, saltLength)
+
+	// 解析私钥并创建签名器
+	signer, err := RsaNewSigner(privateKeyGo, opts)
+
+	// 保存句柄
+	return createHandleResult(signer, err)
+}
+
+//export goRsaSignUpdate
+func goRsaSignUpdate(handle C.ulonglong, data *C.byte, dataLen C.int) C.BoolResult {
+	signer, err := loadHandle[*RsaSigner](uint64(handle))
+	if err != nil {
+		return createBoolResult(false, err)
+	}
+
+	// 追加数据
+	signer.Update(goCBytes2GoSlice(data, dataLen))
+
+	// 设置结果
+	return createBoolResult(true, nil)
+}
+
+//export goRsaSignFinish
+func goRsaSignFinish(handle C.ulonglong) C.ByteArray {
+	signer, err := loadHandle[*RsaSigner](uint64(handle))
+	if err != nil {
+		return goBytes2CByteArray(nil, err)
+	}
+
+	// 签名已写入的全部数据
+	signature, err := signer.Sign()
+
+	// 转换结果，句柄需调用goRsaSignFree释放
+	return goBytes2CByteArray(signature, err)
+}
+
+//export goRsaSignFree
+func goRsaSignFree(handle C.ulonglong) C.BoolResult {
+	// 释放句柄，句柄类型不符时返回错误
+	err := freeHandle[*RsaSigner](uint64(handle))
+
+	// 设置结果
+	return createBoolResult(err == nil, err)
+}
+
+//export goRsaVerifyCreate
+func goRsaVerifyCreate(publicKey *C.byte, publicKeyLen C.int, hash C.int, padding C.int, saltLength C.int) C.HandleResult {
+	// 转换C字节数组为Go切片
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	opts := newRsaSignOptions(hash, padding, saltLength)
+
+	// 解析公钥并创建验证器
+	verifier, err := RsaNewVerifier(publicKeyGo, opts)
+
+	// 保存句柄
+	return createHandleResult(verifier, err)
+}
+
+//export goRsaVerifyUpdate
+func goRsaVerifyUpdate(handle C.ulonglong, data *C.byte, dataLen C.int) C.BoolResult {
+	verifier, err := loadHandle[*RsaVerifier](uint64(handle))
+	if err != nil {
+		return createBoolResult(false, err)
+	}
+
+	// 追加数据
+	verifier.Update(goCBytes2GoSlice(data, dataLen))
+
+	// 设置结果
+	return createBoolResult(true, nil)
+}
+
+//export goRsaVerifyFinish
+func goRsaVerifyFinish(handle C.ulonglong, signature *C.byte, signatureLen C.int) C.BoolResult {
+	verifier, err := loadHandle[*RsaVerifier](uint64(handle))
+	if err != nil {
+		return createBoolResult(false, err)
+	}
+
+	// 验证已写入的全部数据的签名
+	verified, err := verifier.Verify(goCBytes2GoSlice(signature, signatureLen))
+
+	// 设置结果，句柄需调用goRsaVerifyFree释放
+	return createBoolResult(verified, err)
+}
+
+//export goRsaVerifyFree
+func goRsaVerifyFree(handle C.ulonglong) C.BoolResult {
+	// 释放句柄，句柄类型不符时返回错误
+	err := freeHandle[*RsaVerifier](uint64(handle))
+
+	// 设置结果
+	return createBoolResult(err == nil, err)
+}
+
+// 密钥派生接口导出函数
+//
+//export goKdfPbkdf2
+func goKdfPbkdf2(password *C.byte, passwordLen C.int, salt *C.byte, saltLen C.int, iterations C.int, keyLength C.int, hash C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	passwordGo := goCBytes2GoSlice(password, passwordLen)
+	saltGo := goCBytes2GoSlice(salt, saltLen)
+	opts := &KdfPbkdf2Options{
+		Hash:       HashAlgorithm(hash),
+		Iterations: int(iterations),
+		KeyLength:  int(keyLength),
+	}
+
+	// 派生密钥
+	key, err := KdfPbkdf2(passwordGo, saltGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(key, err)
+}
+
+//export goKdfScrypt
+func goKdfScrypt(password *C.byte, passwordLen C.int, salt *C.byte, saltLen C.int, n C.int, r C.int, p C.int, keyLength C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	passwordGo := goCBytes2GoSlice(password, passwordLen)
+	saltGo := goCBytes2GoSlice(salt, saltLen)
+	opts := &KdfScryptOptions{
+		N:         int(n),
+		R:         int(r),
+		P:         int(p),
+		KeyLength: int(keyLength),
+	}
+
+	// 派生密钥
+	key, err := KdfScrypt(passwordGo, saltGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(key, err)
+}
+
+// newKdfArgon2Options 将C参数转换为Argon2选项
+func newKdfArgon2Options(time C.int, memory C.int, threads C.int, keyLength C.int) *KdfArgon2Options {
+	return &KdfArgon2Options{
+		Time:      int(time),
+		Memory:    int(memory),
+		Threads:   int(threads),
+		KeyLength: int(keyLength),
+	}
+}
+
+//export goKdfArgon2id
+func goKdfArgon2id(password *C.byte, passwordLen C.int, salt *C.byte, saltLen C.int, time C.int, memory C.int, threads C.int, keyLength C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	passwordGo := goCBytes2GoSlice(password, passwordLen)
+	saltGo := goCBytes2GoSlice(salt, saltLen)
+	opts := newKdfArgon2Options(time, memory, threads, keyLength)
+
+	// 派生密钥
+	key, err := KdfArgon2id(passwordGo, saltGo, opts)
+
+	// 转换结果
+	return goBytes2CByteArray(key, err)
+}
+
+//export goKdfHkdf
+func goKdfHkdf(secret *C.byte, secretLen C.int, salt *C.byte, saltLen C.int, info *C.byte, infoLen C.int, length C.int, hash C.int) C.ByteArray {
+	// 转换C字节数组为Go切片
+	secretGo := goCBytes2GoSlice(secret, secretLen)
+	saltGo := goCBytes2GoSlice(salt, saltLen)
+	infoGo := goCBytes2GoSlice(info, infoLen)
+
+	// 派生密钥
+	key, err := KdfHkdf(secretGo, saltGo, infoGo, int(length), HashAlgorithm(hash))
+
+	// 转换结果
+	return goBytes2CByteArray(key, err)
+}
+
+//export goKdfHashPassword
+func goKdfHashPassword(password *C.char, time C.int, memory C.int, threads C.int) C.StringResult {
+	// 转换C字符串为Go字符串
+	passwordGo := C.GoString(password)
+	opts := newKdfArgon2Options(time, memory, threads, 0)
+
+	// 计算口令哈希
+	encodedHash, err := KdfHashPassword(passwordGo, opts)
+
+	// 设置结果
+	return createStringResult(encodedHash, err)
+}
+
+//export goKdfVerifyPassword
+func goKdfVerifyPassword(password *C.char, encodedHash *C.char) C.BoolResult {
+	// 转换C字符串为Go字符串
+	passwordGo := C.GoString(password)
+	encodedHashGo := C.GoString(encodedHash)
+
+	// 验证口令
+	verified, err := KdfVerifyPassword(passwordGo, encodedHashGo)
+
+	// 设置结果
+	return createBoolResult(verified, err)
+}
+
+// 随机数接口导出函数
+//
+//export goRandomBytes
+func goRandomBytes(n C.int) C.ByteArray {
+	// 生成随机字节
+	b, err := RandomBytes(int(n))
+
+	// 转换结果
+	return goBytes2CByteArray(b, err)
+}
+
+//export goRandomInt
+func goRandomInt(min C.longlong, max C.longlong) C.IntResult {
+	// 生成[min, max]区间内的随机整数
+	n, err := RandomInt(int64(min), int64(max))
+
+	// 设置结果
+	return createIntResult(n, err)
+}
+
+//export goRandomToken
+func goRandomToken(n C.int) C.StringResult {
+	// 生成URL安全的随机令牌
+	token, err := RandomToken(int(n))
+
+	// 设置结果
+	return createStringResult(token, err)
+}
+
+//export goRandomUuidV4
+func goRandomUuidV4() C.StringResult {
+	// 生成随机UUID
+	uuid, err := RandomUuidV4()
+
+	// 设置结果
+	return createStringResult(uuid, err)
+}
+
+//export goRandomUuidV7
+func goRandomUuidV7() C.StringResult {
+	// 生成按时间排序的UUID
+	uuid, err := RandomUuidV7()
+
+	// 设置结果
+	return createStringResult(uuid, err)
+}
+
+// JWK接口导出函数
+//
+//export goJwkFromPublicKey
+func goJwkFromPublicKey(publicKey *C.byte, publicKeyLen C.int, kid *C.char) C.StringResult {
+	// 转换C字节数组和C字符串为Go类型
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+	kidGo := C.GoString(kid)
+
+	// 公钥转换为JWK
+	jwk, err := JwkFromPublicKey(publicKeyGo, kidGo)
+
+	// 设置结果
+	return createStringResult(jwk, err)
+}
+
+//export goJwkFromPrivateKey
+func goJwkFromPrivateKey(privateKey *C.byte, privateKeyLen C.int, kid *C.char) C.StringResult {
+	// 转换C字节数组和C字符串为Go类型
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+	kidGo := C.GoString(kid)
+
+	// 私钥转换为JWK
+	jwk, err := JwkFromPrivateKey(privateKeyGo, kidGo)
+
+	// 设置结果
+	return createStringResult(jwk, err)
+}
+
+//export goJwkToPublicKey
+func goJwkToPublicKey(jwk *C.char) C.ByteArray {
+	// 转换C字符串为Go字符串
+	jwkGo := C.GoString(jwk)
+
+	// JWK转换为PKIX公钥
+	publicKey, err := JwkToPublicKey(jwkGo)
+
+	// 转换结果
+	return goBytes2CByteArray(publicKey, err)
+}
+
+//export goJwkToPrivateKey
+func goJwkToPrivateKey(jwk *C.char) C.ByteArray {
+	// 转换C字符串为Go字符串
+	jwkGo := C.GoString(jwk)
+
+	// JWK转换为PKCS8私钥
+	privateKey, err := JwkToPrivateKey(jwkGo)
+
+	// 转换结果
+	return goBytes2CByteArray(privateKey, err)
+}
+
+//export goJwkThumbprint
+func goJwkThumbprint(jwk *C.char) C.StringResult {
+	// 转换C字符串为Go字符串
+	jwkGo := C.GoString(jwk)
+
+	// 计算JWK指纹
+	thumbprint, err := JwkThumbprint(jwkGo)
+
+	// 设置结果
+	return createStringResult(thumbprint, err)
+}
+
+//export goJwksAddKey
+func goJwksAddKey(jwks *C.char, jwk *C.char) C.StringResult {
+	// 转换C字符串为Go字符串
+	jwksGo := C.GoString(jwks)
+	jwkGo := C.GoString(jwk)
+
+	// 添加JWK到密钥集
+	result, err := JwksAddKey(jwksGo, jwkGo)
+
+	// 设置结果
+	return createStringResult(result, err)
+}
+
+//export goJwksLookupKey
+func goJwksLookupKey(jwks *C.char, kid *C.char) C.StringResult {
+	// 转换C字符串为Go字符串
+	jwksGo := C.GoString(jwks)
+	kidGo := C.GoString(kid)
+
+	// 按kid查找JWK
+	jwk, err := JwksLookupKey(jwksGo, kidGo)
+
+	// 设置结果
+	return createStringResult(jwk, err)
+}
+
+// JWT接口导出函数
+//
+//export goJwtSign
+func goJwtSign(claims *C.char, privateKey *C.byte, privateKeyLen C.int, algorithm *C.char, kid *C.char) C.StringResult {
+	// 转换C字节数组和C字符串为Go类型
+	claimsGo := C.GoString(claims)
+	privateKeyGo := goCBytes2GoSlice(privateKey, privateKeyLen)
+
+	// algorithm 为空时按私钥类型选择
+	opts := &JwtSignOptions{
+		Algorithm: JwtAlgorithm(C.GoString(algorithm)),
+		Kid:       C.GoString(kid),
+	}
+
+	// 签发JWT
+	token, err := JwtSign(claimsGo, privateKeyGo, opts)
+
+	// 设置结果
+	return createStringResult(token, err)
+}
+
+//export goJwtVerify
+func goJwtVerify(token *C.char, publicKey *C.byte, publicKeyLen C.int, algorithm *C.char, issuer *C.char, audience *C.char, clockSkewSeconds C.int) C.StringResult {
+	// 转换C字节数组和C字符串为Go类型
+	tokenGo := C.GoString(token)
+	publicKeyGo := goCBytes2GoSlice(publicKey, publicKeyLen)
+
+	// algorithm 为空时接受所有支持的算法
+	opts := &JwtVerifyOptions{
+		Issuer:    C.GoString(issuer),
+		Audience:  C.GoString(audience),
+		ClockSkew: time.Duration(clockSkewSeconds) * time.Second,
+	}
+	if alg := C.GoString(algorithm); alg != "" {
+		opts.Algorithms = []JwtAlgorithm{JwtAlgorithm(alg)}
+	}
+
+	// 验证JWT并返回声明
+	claims, err := JwtVerify(tokenGo, publicKeyGo, opts)
+
+	// 设置结果
+	return createStringResult(claims, err)
+}
+
+//export goJwtDecodeHeader
+func goJwtDecodeHeader(token *C.char) C.StringResult {
+	// 转换C字符串为Go字符串
+	tokenGo := C.GoString(token)
+
+	// 解码JWT头部（不验证）
+	header, err := JwtDecodeHeader(tokenGo)
+
+	// 设置结果
+	return createStringResult(header, err)
+}
+
+//export goJwtDecodeClaims
+func goJwtDecodeClaims(token *C.char) C.StringResult {
+	// 转换C字符串为Go字符串
+	tokenGo := C.GoString(token)
+
+	// 解码JWT声明（不验证）
+	claims, err := JwtDecodeClaims(tokenGo)
+
+	// 设置结果
+	return createStringResult(claims, err)
+}
+
+// 内存管理函数导出
+
+//export goFreeByteArray
+func goFreeByteArray(result C.ByteArray) {
+	freeByteArray(&result)
+}
+
+//export goFreeRsaKeyPair
+func goFreeRsaKeyPair(result C.RsaKeyPair) {
+	freeRsaKeyPair(&result)
+}
+
+//export goFreeEcdsaKeyPair
+func goFreeEcdsaKeyPair(result C.EcdsaKeyPair) {
+	freeEcdsaKeyPair(&result)
+}
+
+//export goFreeEd25519KeyPair
+func goFreeEd25519KeyPair(result C.Ed25519KeyPair) {
+	freeEd25519KeyPair(&result)
+}
+
+//export goFreeEcdhKeyPair
+func goFreeEcdhKeyPair(result C.EcdhKeyPair) {
+	freeEcdhKeyPair(&result)
+}
+
+//export goFreeSm2KeyPair
+func goFreeSm2KeyPair(result C.Sm2KeyPair) {
+	freeSm2KeyPair(&result)
+}
+
+//export goFreeHandleResult
+func goFreeHandleResult(result C.HandleResult) {
+	freeHandleResult(&result)
+}
+
+//export goFreeIntResult
+func goFreeIntResult(result C.IntResult) {
+	freeIntResult(&result)
 }
 
 //export goFreeStringResult
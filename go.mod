@@ -1,3 +1,10 @@
 module go-secure-utils
 
-go 1.24
+go 1.24.0
+
+require (
+	github.com/emmansun/gmsm v0.29.7
+	golang.org/x/crypto v0.45.0
+)
+
+require golang.org/x/sys v0.38.0 // indirect
@@ -24,6 +24,34 @@ typedef struct {
     char* error; // NULL if no error
 } RsaKeyPair;
 
+// ECDSA密钥对结构
+typedef struct {
+    ByteArray publicKey;
+    ByteArray privateKey;
+    char* error; // NULL if no error
+} EcdsaKeyPair;
+
+// Ed25519密钥对结构
+typedef struct {
+    ByteArray publicKey;
+    ByteArray privateKey;
+    char* error; // NULL if no error
+} Ed25519KeyPair;
+
+// ECDH密钥对结构
+typedef struct {
+    ByteArray publicKey;
+    ByteArray privateKey;
+    char* error; // NULL if no error
+} EcdhKeyPair;
+
+// SM2密钥对结构
+typedef struct {
+    ByteArray publicKey;
+    ByteArray privateKey;
+    char* error; // NULL if no error
+} Sm2KeyPair;
+
 // 字符串结果结构
 typedef struct {
     char* data;
@@ -36,6 +64,116 @@ typedef struct {
     char* error; // NULL if no error
 } BoolResult;
 
+// 句柄结果结构，用于流式摘要和签名
+typedef struct {
+    unsigned long long handle; // 0 if error
+    char* error; // NULL if no error
+} HandleResult;
+
+// 整数结果结构
+typedef struct {
+    long long value;
+    char* error; // NULL if no error
+} IntResult;
+
+// RSA摘要算法，0表示使用默认值
+typedef enum {
+    RSA_HASH_DEFAULT = 0,
+    RSA_HASH_SHA1 = 1,
+    RSA_HASH_SHA256 = 2,
+    RSA_HASH_SHA512 = 3,
+    RSA_HASH_SHA384 = 4,
+    RSA_HASH_SHA224 = 5,
+    RSA_HASH_SHA3_256 = 6,
+} RsaHashType;
+
+// RSA签名填充方式
+typedef enum {
+    RSA_PADDING_PKCS1V15 = 0,
+    RSA_PADDING_PSS = 1,
+} RsaPaddingType;
+
+// RSA私钥格式
+typedef enum {
+    RSA_PRIVATE_KEY_PKCS1 = 0,
+    RSA_PRIVATE_KEY_PKCS8 = 1,
+} RsaPrivateKeyFormat;
+
+// RSA公钥格式
+typedef enum {
+    RSA_PUBLIC_KEY_PKIX = 0,
+    RSA_PUBLIC_KEY_PKCS1 = 1,
+} RsaPublicKeyFormat;
+
+// 密钥输出编码，PEM和Base64时ByteArray内容为文本
+typedef enum {
+    RSA_KEY_ENCODING_DER = 0,
+    RSA_KEY_ENCODING_PEM = 1,
+    RSA_KEY_ENCODING_BASE64 = 2,
+} RsaKeyEncoding;
+
+// 私钥加密的密钥派生算法
+typedef enum {
+    RSA_KDF_PBKDF2 = 0,
+    RSA_KDF_SCRYPT = 1,
+} RsaKeyDerivation;
+
+// ECDSA曲线，0表示使用默认值P-256
+typedef enum {
+    ECDSA_CURVE_DEFAULT = 0,
+    ECDSA_CURVE_P256 = 1,
+    ECDSA_CURVE_P384 = 2,
+    ECDSA_CURVE_P521 = 3,
+} EcdsaCurve;
+
+// ECDH曲线，0表示使用默认值X25519
+typedef enum {
+    ECDH_CURVE_DEFAULT = 0,
+    ECDH_CURVE_X25519 = 1,
+    ECDH_CURVE_P256 = 2,
+} EcdhCurve;
+
+// AES-CBC填充方式
+typedef enum {
+    AES_PADDING_PKCS7 = 0,
+    AES_PADDING_ZERO = 1,
+    AES_PADDING_NONE = 2,
+} AesPadding;
+
+// SM2密文拼接顺序
+typedef enum {
+    SM2_CIPHERTEXT_C1C3C2 = 0,
+    SM2_CIPHERTEXT_C1C2C3 = 1,
+} Sm2CiphertextOrder;
+
+// SM4-ECB/CBC填充方式
+typedef enum {
+    SM4_PADDING_PKCS7 = 0,
+    SM4_PADDING_ZERO = 1,
+    SM4_PADDING_NONE = 2,
+} Sm4Padding;
+
+// 摘要算法，0表示使用默认值SHA-256
+typedef enum {
+    HASH_ALGORITHM_DEFAULT = 0,
+    HASH_ALGORITHM_MD5 = 1,
+    HASH_ALGORITHM_SHA1 = 2,
+    HASH_ALGORITHM_SHA224 = 3,
+    HASH_ALGORITHM_SHA256 = 4,
+    HASH_ALGORITHM_SHA384 = 5,
+    HASH_ALGORITHM_SHA512 = 6,
+    HASH_ALGORITHM_SHA512_224 = 7,
+    HASH_ALGORITHM_SHA512_256 = 8,
+    HASH_ALGORITHM_SHA3_224 = 9,
+    HASH_ALGORITHM_SHA3_256 = 10,
+    HASH_ALGORITHM_SHA3_384 = 11,
+    HASH_ALGORITHM_SHA3_512 = 12,
+    HASH_ALGORITHM_BLAKE2B_256 = 13,
+    HASH_ALGORITHM_BLAKE2B_384 = 14,
+    HASH_ALGORITHM_BLAKE2B_512 = 15,
+    HASH_ALGORITHM_BLAKE2S_256 = 16,
+} HashAlgorithm;
+
 // ========= RSA API函数 =========
 
 // RSA密钥对生成与管理函数
@@ -90,6 +228,639 @@ BoolResult goRsaVerifyFromBase64(char* data, byte* publicKey, int publicKeyLen,
 // 使用SHA1哈希算法验证签名
 BoolResult goRsaVerifySha1(byte* data, int dataLen, byte* publicKey, int publicKeyLen, byte* signature, int signatureLen);
 
+// RSA-OAEP加解密函数
+
+// 使用OAEP填充和公钥加密数据，hash和mgfHash为RsaHashType，label可为NULL
+ByteArray goRsaEncryptOaep(byte* data, int dataLen, byte* publicKey, int publicKeyLen, int hash, int mgfHash, byte* label, int labelLen);
+
+// 使用OAEP填充加密数据并返回Base64编码的结果
+StringResult goRsaEncryptOaepBase64(byte* data, int dataLen, byte* publicKey, int publicKeyLen, int hash, int mgfHash, byte* label, int labelLen);
+
+// 使用OAEP填充和私钥解密数据，参数须与加密时一致
+ByteArray goRsaDecryptOaep(byte* encryptedData, int encryptedDataLen, byte* privateKey, int privateKeyLen, int hash, int mgfHash, byte* label, int labelLen);
+
+// 解密Base64编码的OAEP加密数据
+ByteArray goRsaDecryptOaepFromBase64(char* encryptedBase64, byte* privateKey, int privateKeyLen, int hash, int mgfHash, byte* label, int labelLen);
+
+// RSA-PSS签名函数
+
+// 使用PSS填充签名数据，saltLength为0时盐长度等于摘要长度
+ByteArray goRsaSignPss(byte* data, int dataLen, byte* privateKey, int privateKeyLen, int hash, int saltLength);
+
+// 使用PSS填充对字符串数据签名并返回Base64编码的结果
+StringResult goRsaSignPssBase64(char* data, byte* privateKey, int privateKeyLen, int hash, int saltLength);
+
+// 验证PSS签名
+BoolResult goRsaVerifyPss(byte* data, int dataLen, byte* publicKey, int publicKeyLen, byte* signature, int signatureLen, int hash, int saltLength);
+
+// 验证Base64编码的PSS签名
+BoolResult goRsaVerifyPssFromBase64(char* data, byte* publicKey, int publicKeyLen, char* signatureBase64, int hash, int saltLength);
+
+// 按选项签名函数
+
+// 按指定摘要算法和填充方式签名数据
+ByteArray goRsaSignWithOptions(byte* data, int dataLen, byte* privateKey, int privateKeyLen, int hash, int padding, int saltLength);
+
+// 按指定摘要算法和填充方式验证签名
+BoolResult goRsaVerifyWithOptions(byte* data, int dataLen, byte* publicKey, int publicKeyLen, byte* signature, int signatureLen, int hash, int padding, int saltLength);
+
+// 预计算摘要签名函数
+
+// 对预先计算的摘要签名，摘要长度须与hash一致
+ByteArray goRsaSignDigest(byte* digest, int digestLen, byte* privateKey, int privateKeyLen, int hash, int padding, int saltLength);
+
+// 验证预先计算的摘要的签名
+BoolResult goRsaVerifyDigest(byte* digest, int digestLen, byte* publicKey, int publicKeyLen, byte* signature, int signatureLen, int hash, int padding, int saltLength);
+
+// RSA分段加解密函数（兼容Java/Android）
+
+// 使用PKCS#1 v1.5填充分段加密任意长度数据
+ByteArray goRsaEncryptSegmented(byte* data, int dataLen, byte* publicKey, int publicKeyLen);
+
+// 分段加密数据并返回Base64编码的结果
+StringResult goRsaEncryptSegmentedBase64(byte* data, int dataLen, byte* publicKey, int publicKeyLen);
+
+// 分段解密数据
+ByteArray goRsaDecryptSegmented(byte* encryptedData, int encryptedDataLen, byte* privateKey, int privateKeyLen);
+
+// 分段解密Base64编码的加密数据
+ByteArray goRsaDecryptSegmentedFromBase64(char* encryptedBase64, byte* privateKey, int privateKeyLen);
+
+// 使用OAEP填充分段加密任意长度数据
+ByteArray goRsaEncryptOaepSegmented(byte* data, int dataLen, byte* publicKey, int publicKeyLen, int hash, int mgfHash, byte* label, int labelLen);
+
+// 使用OAEP填充分段加密数据并返回Base64编码的结果
+StringResult goRsaEncryptOaepSegmentedBase64(byte* data, int dataLen, byte* publicKey, int publicKeyLen, int hash, int mgfHash, byte* label, int labelLen);
+
+// 使用OAEP填充分段解密数据
+ByteArray goRsaDecryptOaepSegmented(byte* encryptedData, int encryptedDataLen, byte* privateKey, int privateKeyLen, int hash, int mgfHash, byte* label, int labelLen);
+
+// 使用OAEP填充分段解密Base64编码的加密数据
+ByteArray goRsaDecryptOaepSegmentedFromBase64(char* encryptedBase64, byte* privateKey, int privateKeyLen, int hash, int mgfHash, byte* label, int labelLen);
+
+// PEM格式转换函数
+
+// 将PKCS1或PKCS8私钥转换为PEM格式
+StringResult goRsaPrivateKeyToPem(byte* privateKey, int privateKeyLen);
+
+// 将PKIX或PKCS1公钥转换为PEM格式
+StringResult goRsaPublicKeyToPem(byte* publicKey, int publicKeyLen);
+
+// 解码第一个PEM块并返回DER数据
+ByteArray goRsaPemToDer(char* pemData);
+
+// 密钥格式转换函数
+
+// 将PKCS8私钥转换为PKCS1格式
+ByteArray goRsaConvertPkcs8ToPkcs1(byte* privateKey, int privateKeyLen);
+
+// 将PKCS1私钥转换为PKCS8格式
+ByteArray goRsaConvertPkcs1ToPkcs8(byte* privateKey, int privateKeyLen);
+
+// 将公钥转换为PKCS1格式
+ByteArray goRsaConvertPublicKeyToPkcs1(byte* publicKey, int publicKeyLen);
+
+// 将公钥转换为PKIX格式
+ByteArray goRsaConvertPublicKeyToPkix(byte* publicKey, int publicKeyLen);
+
+// 按选项生成密钥对
+
+// 按指定格式、编码和公钥指数生成RSA密钥对，publicExponent为0时使用65537
+RsaKeyPair goRsaGenKeyPairWithOptions(int bits, int privateKeyFormat, int publicKeyFormat, int encoding, int publicExponent);
+
+// 私钥口令加密函数
+
+// 使用口令将私钥加密为PKCS8 EncryptedPrivateKeyInfo，参数为0时使用默认值
+ByteArray goRsaEncryptPrivateKey(byte* privateKey, int privateKeyLen, byte* password, int passwordLen, int kdf, int iterations, int scryptN, int scryptR, int scryptP);
+
+// 使用口令加密私钥并输出ENCRYPTED PRIVATE KEY格式的PEM
+StringResult goRsaEncryptPrivateKeyToPem(byte* privateKey, int privateKeyLen, byte* password, int passwordLen, int kdf, int iterations, int scryptN, int scryptR, int scryptP);
+
+// 使用口令解密私钥（DER或PEM），返回PKCS8私钥
+ByteArray goRsaDecryptPrivateKey(byte* encryptedKey, int encryptedKeyLen, byte* password, int passwordLen);
+
+// OpenSSH格式函数
+
+// 将公钥转换为authorized_keys格式，comment可为空
+StringResult goRsaPublicKeyToOpenSsh(byte* publicKey, int publicKeyLen, char* comment);
+
+// 解析authorized_keys格式公钥，返回PKIX公钥
+ByteArray goRsaOpenSshToPublicKey(char* authorizedKey);
+
+// 将私钥转换为OPENSSH PRIVATE KEY格式，passphrase可为NULL
+StringResult goRsaPrivateKeyToOpenSsh(byte* privateKey, int privateKeyLen, byte* passphrase, int passphraseLen, char* comment);
+
+// 解析OPENSSH PRIVATE KEY格式私钥，返回PKCS8私钥
+ByteArray goRsaOpenSshToPrivateKey(char* pemData, byte* passphrase, int passphraseLen);
+
+// 计算公钥的OpenSSH SHA256指纹
+StringResult goRsaOpenSshFingerprint(byte* publicKey, int publicKeyLen);
+
+// ========= 信封加密API函数 =========
+
+// 使用RSA-OAEP包装的随机AES-256-GCM密钥加密任意长度数据
+ByteArray goEnvelopeSeal(byte* data, int dataLen, byte* publicKey, int publicKeyLen);
+
+// 信封加密数据并返回Base64编码的结果
+StringResult goEnvelopeSealBase64(byte* data, int dataLen, byte* publicKey, int publicKeyLen);
+
+// 使用私钥解密信封
+ByteArray goEnvelopeOpen(byte* sealed, int sealedLen, byte* privateKey, int privateKeyLen);
+
+// 解密Base64编码的信封
+ByteArray goEnvelopeOpenFromBase64(char* sealedBase64, byte* privateKey, int privateKeyLen);
+
+// ========= ECDSA API函数 =========
+
+// ECDSA密钥对生成与管理函数
+
+// 生成ECDSA密钥对，私钥为PKCS8格式，公钥为PKIX格式
+EcdsaKeyPair goEcdsaGenKeyPair(int curve);
+
+// 从私钥提取公钥
+ByteArray goEcdsaExtractPublicKey(byte* privateKey, int privateKeyLen);
+
+// 将PKCS8私钥转换为SEC1格式
+ByteArray goEcdsaConvertPkcs8ToSec1(byte* privateKey, int privateKeyLen);
+
+// 将SEC1私钥转换为PKCS8格式
+ByteArray goEcdsaConvertSec1ToPkcs8(byte* privateKey, int privateKeyLen);
+
+// 将私钥转换为PEM格式
+StringResult goEcdsaPrivateKeyToPem(byte* privateKey, int privateKeyLen);
+
+// 将公钥转换为PEM格式
+StringResult goEcdsaPublicKeyToPem(byte* publicKey, int publicKeyLen);
+
+// ECDSA签名与验证函数
+
+// 使用私钥签名数据，返回DER签名，摘要算法由曲线决定
+ByteArray goEcdsaSign(byte* data, int dataLen, byte* privateKey, int privateKeyLen);
+
+// 对字符串数据签名并返回Base64编码的DER签名
+StringResult goEcdsaSignBase64(char* data, byte* privateKey, int privateKeyLen);
+
+// 使用私钥签名数据，返回定长r||s签名
+ByteArray goEcdsaSignRaw(byte* data, int dataLen, byte* privateKey, int privateKeyLen);
+
+// 验证DER签名
+BoolResult goEcdsaVerify(byte* data, int dataLen, byte* publicKey, int publicKeyLen, byte* signature, int signatureLen);
+
+// 验证Base64编码的DER签名
+BoolResult goEcdsaVerifyFromBase64(char* data, byte* publicKey, int publicKeyLen, char* signatureBase64);
+
+// 验证定长r||s签名，长度须与公钥曲线一致
+BoolResult goEcdsaVerifyRaw(byte* data, int dataLen, byte* publicKey, int publicKeyLen, byte* signature, int signatureLen);
+
+// 将DER签名转换为r||s格式，curve必须显式指定
+ByteArray goEcdsaDerToRawSignature(byte* signature, int signatureLen, int curve);
+
+// 将r||s签名转换为DER格式
+ByteArray goEcdsaRawToDerSignature(byte* signature, int signatureLen);
+
+// ========= Ed25519 API函数 =========
+
+// Ed25519密钥对生成与管理函数
+
+// 生成Ed25519密钥对，私钥为PKCS8格式，公钥为PKIX格式
+Ed25519KeyPair goEd25519GenKeyPair(void);
+
+// 从32字节种子生成密钥对
+Ed25519KeyPair goEd25519GenKeyPairFromSeed(byte* seed, int seedLen);
+
+// 从私钥提取公钥
+ByteArray goEd25519ExtractPublicKey(byte* privateKey, int privateKeyLen);
+
+// 从私钥提取32字节种子
+ByteArray goEd25519PrivateKeyToSeed(byte* privateKey, int privateKeyLen);
+
+// 将PKIX公钥转换为32字节原始公钥
+ByteArray goEd25519PublicKeyToRaw(byte* publicKey, int publicKeyLen);
+
+// 将32字节原始公钥转换为PKIX格式
+ByteArray goEd25519RawToPublicKey(byte* raw, int rawLen);
+
+// 将私钥转换为PEM格式
+StringResult goEd25519PrivateKeyToPem(byte* privateKey, int privateKeyLen);
+
+// 将公钥转换为PEM格式
+StringResult goEd25519PublicKeyToPem(byte* publicKey, int publicKeyLen);
+
+// Ed25519签名与验证函数
+
+// 使用私钥签名数据
+ByteArray goEd25519Sign(byte* data, int dataLen, byte* privateKey, int privateKeyLen);
+
+// 对字符串数据签名并返回Base64编码的结果
+StringResult goEd25519SignBase64(char* data, byte* privateKey, int privateKeyLen);
+
+// 验证签名
+BoolResult goEd25519Verify(byte* data, int dataLen, byte* publicKey, int publicKeyLen, byte* signature, int signatureLen);
+
+// 验证Base64编码的签名
+BoolResult goEd25519VerifyFromBase64(char* data, byte* publicKey, int publicKeyLen, char* signatureBase64);
+
+// ========= ECDH API函数 =========
+
+// ECDH密钥对生成与管理函数
+
+// 生成ECDH密钥对，私钥为PKCS8格式，公钥为PKIX格式
+EcdhKeyPair goEcdhGenKeyPair(int curve);
+
+// 从私钥提取公钥
+ByteArray goEcdhExtractPublicKey(byte* privateKey, int privateKeyLen);
+
+// 将PKIX公钥转换为原始公钥（X25519为32字节，P-256为65字节未压缩点）
+ByteArray goEcdhPublicKeyToRaw(byte* publicKey, int publicKeyLen);
+
+// 将原始公钥转换为PKIX格式
+ByteArray goEcdhRawToPublicKey(byte* raw, int rawLen);
+
+// 将私钥转换为PEM格式
+StringResult goEcdhPrivateKeyToPem(byte* privateKey, int privateKeyLen);
+
+// 将公钥转换为PEM格式
+StringResult goEcdhPublicKeyToPem(byte* publicKey, int publicKeyLen);
+
+// ECDH密钥协商函数
+
+// 计算与对端公钥的共享密钥
+ByteArray goEcdhSharedSecret(byte* privateKey, int privateKeyLen, byte* peerPublicKey, int peerPublicKeyLen);
+
+// 使用HKDF-SHA256从共享密钥派生密钥，length为0时使用32字节
+ByteArray goEcdhDeriveKey(byte* sharedSecret, int sharedSecretLen, byte* salt, int saltLen, byte* info, int infoLen, int length);
+
+// 计算共享密钥并使用HKDF-SHA256派生会话密钥
+ByteArray goEcdhDeriveSessionKey(byte* privateKey, int privateKeyLen, byte* peerPublicKey, int peerPublicKeyLen, byte* salt, int saltLen, byte* info, int infoLen, int length);
+
+// ========= AES API函数 =========
+
+// AES-GCM函数
+
+// 生成随机AES密钥，keySize为16、24或32，0时使用32
+ByteArray goAesGenKey(int keySize);
+
+// 使用AES-GCM加密数据，输出nonce||密文||tag，additionalData可为NULL
+ByteArray goAesEncryptGcm(byte* data, int dataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用AES-GCM加密数据并返回Base64编码的结果
+StringResult goAesEncryptGcmBase64(byte* data, int dataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用AES-GCM解密数据
+ByteArray goAesDecryptGcm(byte* encryptedData, int encryptedDataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用AES-GCM解密Base64编码的数据
+ByteArray goAesDecryptGcmFromBase64(char* encryptedBase64, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// AES-CBC函数
+
+// 生成16字节随机IV
+ByteArray goAesGenIv(void);
+
+// 使用AES-CBC加密数据，padding为AesPadding
+ByteArray goAesEncryptCbc(byte* data, int dataLen, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用AES-CBC加密数据并返回Base64编码的结果
+StringResult goAesEncryptCbcBase64(byte* data, int dataLen, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用AES-CBC加密数据并返回十六进制编码的结果
+StringResult goAesEncryptCbcHex(byte* data, int dataLen, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用AES-CBC解密数据
+ByteArray goAesDecryptCbc(byte* encryptedData, int encryptedDataLen, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用AES-CBC解密Base64编码的数据
+ByteArray goAesDecryptCbcFromBase64(char* encryptedBase64, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用AES-CBC解密十六进制编码的数据
+ByteArray goAesDecryptCbcFromHex(char* encryptedHex, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// AES-CTR函数
+
+// 使用AES-CTR加密数据
+ByteArray goAesEncryptCtr(byte* data, int dataLen, byte* key, int keyLen, byte* iv, int ivLen);
+
+// 使用AES-CTR加密数据并返回Base64编码的结果
+StringResult goAesEncryptCtrBase64(byte* data, int dataLen, byte* key, int keyLen, byte* iv, int ivLen);
+
+// 使用AES-CTR加密数据并返回十六进制编码的结果
+StringResult goAesEncryptCtrHex(byte* data, int dataLen, byte* key, int keyLen, byte* iv, int ivLen);
+
+// 使用AES-CTR解密数据
+ByteArray goAesDecryptCtr(byte* encryptedData, int encryptedDataLen, byte* key, int keyLen, byte* iv, int ivLen);
+
+// 使用AES-CTR解密Base64编码的数据
+ByteArray goAesDecryptCtrFromBase64(char* encryptedBase64, byte* key, int keyLen, byte* iv, int ivLen);
+
+// 使用AES-CTR解密十六进制编码的数据
+ByteArray goAesDecryptCtrFromHex(char* encryptedHex, byte* key, int keyLen, byte* iv, int ivLen);
+
+// ========= ChaCha20-Poly1305 API函数 =========
+
+// 生成32字节随机密钥
+ByteArray goChaCha20Poly1305GenKey(void);
+
+// 使用ChaCha20-Poly1305加密数据，输出nonce||密文||tag
+ByteArray goChaCha20Poly1305Encrypt(byte* data, int dataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用ChaCha20-Poly1305加密数据并返回Base64编码的结果
+StringResult goChaCha20Poly1305EncryptBase64(byte* data, int dataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用ChaCha20-Poly1305解密数据
+ByteArray goChaCha20Poly1305Decrypt(byte* encryptedData, int encryptedDataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用ChaCha20-Poly1305解密Base64编码的数据
+ByteArray goChaCha20Poly1305DecryptFromBase64(char* encryptedBase64, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用XChaCha20-Poly1305（24字节nonce）加密数据
+ByteArray goXChaCha20Poly1305Encrypt(byte* data, int dataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用XChaCha20-Poly1305加密数据并返回Base64编码的结果
+StringResult goXChaCha20Poly1305EncryptBase64(byte* data, int dataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用XChaCha20-Poly1305解密数据
+ByteArray goXChaCha20Poly1305Decrypt(byte* encryptedData, int encryptedDataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用XChaCha20-Poly1305解密Base64编码的数据
+ByteArray goXChaCha20Poly1305DecryptFromBase64(char* encryptedBase64, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// ========= SM2 API函数 =========
+
+// SM2密钥对生成与管理函数
+
+// 生成SM2密钥对，私钥为PKCS8格式，公钥为PKIX格式
+Sm2KeyPair goSm2GenKeyPair(void);
+
+// 从私钥提取公钥
+ByteArray goSm2ExtractPublicKey(byte* privateKey, int privateKeyLen);
+
+// 将私钥转换为PEM格式
+StringResult goSm2PrivateKeyToPem(byte* privateKey, int privateKeyLen);
+
+// 将公钥转换为PEM格式
+StringResult goSm2PublicKeyToPem(byte* publicKey, int publicKeyLen);
+
+// SM2签名与验证函数
+
+// 使用私钥签名数据，返回DER签名，uid为NULL时使用默认用户ID
+ByteArray goSm2Sign(byte* data, int dataLen, byte* privateKey, int privateKeyLen, byte* uid, int uidLen);
+
+// 对字符串数据签名并返回Base64编码的结果
+StringResult goSm2SignBase64(char* data, byte* privateKey, int privateKeyLen, byte* uid, int uidLen);
+
+// 验证DER签名，uid须与签名时一致
+BoolResult goSm2Verify(byte* data, int dataLen, byte* publicKey, int publicKeyLen, byte* signature, int signatureLen, byte* uid, int uidLen);
+
+// 验证Base64编码的签名
+BoolResult goSm2VerifyFromBase64(char* data, byte* publicKey, int publicKeyLen, char* signatureBase64, byte* uid, int uidLen);
+
+// SM2加解密函数
+
+// 使用公钥加密数据，order为Sm2CiphertextOrder
+ByteArray goSm2Encrypt(byte* data, int dataLen, byte* publicKey, int publicKeyLen, int order);
+
+// 使用公钥加密数据并返回Base64编码的结果
+StringResult goSm2EncryptBase64(byte* data, int dataLen, byte* publicKey, int publicKeyLen, int order);
+
+// 使用私钥解密数据
+ByteArray goSm2Decrypt(byte* encryptedData, int encryptedDataLen, byte* privateKey, int privateKeyLen, int order);
+
+// 解密Base64编码的加密数据
+ByteArray goSm2DecryptFromBase64(char* encryptedBase64, byte* privateKey, int privateKeyLen, int order);
+
+// ========= SM3 API函数 =========
+
+// 计算SM3摘要
+ByteArray goSm3Sum(byte* data, int dataLen);
+
+// 计算SM3摘要并返回十六进制编码的结果
+StringResult goSm3SumHex(byte* data, int dataLen);
+
+// 计算HMAC-SM3
+ByteArray goSm3Hmac(byte* data, int dataLen, byte* key, int keyLen);
+
+// 计算HMAC-SM3并返回十六进制编码的结果
+StringResult goSm3HmacHex(byte* data, int dataLen, byte* key, int keyLen);
+
+// 以常量时间验证HMAC-SM3
+BoolResult goSm3VerifyHmac(byte* data, int dataLen, byte* key, int keyLen, byte* mac, int macLen);
+
+// ========= SM4 API函数 =========
+
+// SM4密钥生成函数
+
+// 生成16字节随机密钥
+ByteArray goSm4GenKey(void);
+
+// 生成16字节随机IV
+ByteArray goSm4GenIv(void);
+
+// SM4-ECB函数
+
+// 使用SM4-ECB加密数据，padding为Sm4Padding
+ByteArray goSm4EncryptEcb(byte* data, int dataLen, byte* key, int keyLen, int padding);
+
+// 使用SM4-ECB加密数据并返回Base64编码的结果
+StringResult goSm4EncryptEcbBase64(byte* data, int dataLen, byte* key, int keyLen, int padding);
+
+// 使用SM4-ECB加密数据并返回十六进制编码的结果
+StringResult goSm4EncryptEcbHex(byte* data, int dataLen, byte* key, int keyLen, int padding);
+
+// 使用SM4-ECB解密数据
+ByteArray goSm4DecryptEcb(byte* encryptedData, int encryptedDataLen, byte* key, int keyLen, int padding);
+
+// 使用SM4-ECB解密Base64编码的数据
+ByteArray goSm4DecryptEcbFromBase64(char* encryptedBase64, byte* key, int keyLen, int padding);
+
+// 使用SM4-ECB解密十六进制编码的数据
+ByteArray goSm4DecryptEcbFromHex(char* encryptedHex, byte* key, int keyLen, int padding);
+
+// SM4-CBC函数
+
+// 使用SM4-CBC加密数据
+ByteArray goSm4EncryptCbc(byte* data, int dataLen, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用SM4-CBC加密数据并返回Base64编码的结果
+StringResult goSm4EncryptCbcBase64(byte* data, int dataLen, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用SM4-CBC加密数据并返回十六进制编码的结果
+StringResult goSm4EncryptCbcHex(byte* data, int dataLen, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用SM4-CBC解密数据
+ByteArray goSm4DecryptCbc(byte* encryptedData, int encryptedDataLen, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用SM4-CBC解密Base64编码的数据
+ByteArray goSm4DecryptCbcFromBase64(char* encryptedBase64, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// 使用SM4-CBC解密十六进制编码的数据
+ByteArray goSm4DecryptCbcFromHex(char* encryptedHex, byte* key, int keyLen, byte* iv, int ivLen, int padding);
+
+// SM4-GCM函数
+
+// 使用SM4-GCM加密数据，输出nonce||密文||tag，additionalData可为NULL
+ByteArray goSm4EncryptGcm(byte* data, int dataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用SM4-GCM加密数据并返回Base64编码的结果
+StringResult goSm4EncryptGcmBase64(byte* data, int dataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用SM4-GCM解密数据
+ByteArray goSm4DecryptGcm(byte* encryptedData, int encryptedDataLen, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// 使用SM4-GCM解密Base64编码的数据
+ByteArray goSm4DecryptGcmFromBase64(char* encryptedBase64, byte* key, int keyLen, byte* additionalData, int additionalDataLen);
+
+// ========= 摘要与HMAC API函数 =========
+
+// 摘要函数
+
+// 计算摘要，algorithm为HashAlgorithm
+ByteArray goHashSum(byte* data, int dataLen, int algorithm);
+
+// 计算摘要并返回十六进制编码的结果
+StringResult goHashSumHex(byte* data, int dataLen, int algorithm);
+
+// 计算摘要并返回Base64编码的结果
+StringResult goHashSumBase64(byte* data, int dataLen, int algorithm);
+
+// HMAC函数
+
+// 计算HMAC
+ByteArray goHashHmac(byte* data, int dataLen, byte* key, int keyLen, int algorithm);
+
+// 计算HMAC并返回十六进制编码的结果
+StringResult goHashHmacHex(byte* data, int dataLen, byte* key, int keyLen, int algorithm);
+
+// 计算HMAC并返回Base64编码的结果
+StringResult goHashHmacBase64(byte* data, int dataLen, byte* key, int keyLen, int algorithm);
+
+// 以常量时间验证HMAC
+BoolResult goHashVerifyHmac(byte* data, int dataLen, byte* key, int keyLen, byte* mac, int macLen, int algorithm);
+
+// ========= 流式处理API函数 =========
+
+// 流式摘要与HMAC函数
+
+// 创建摘要计算器，返回句柄
+HandleResult goHashCreate(int algorithm);
+
+// 创建HMAC计算器，返回句柄
+HandleResult goHashCreateHmac(byte* key, int keyLen, int algorithm);
+
+// 向计算器追加数据
+BoolResult goHashUpdate(unsigned long long handle, byte* data, int dataLen);
+
+// 计算结果，之后句柄仍需调用goHashFree释放
+ByteArray goHashFinish(unsigned long long handle);
+
+// 释放计算器句柄，句柄类型不符或已释放时返回错误
+BoolResult goHashFree(unsigned long long handle);
+
+// RSA流式签名函数
+
+// 解析私钥并创建签名器，返回句柄
+HandleResult goRsaSignCreate(byte* privateKey, int privateKeyLen, int hash, int padding, int saltLength);
+
+// 向签名器追加数据
+BoolResult goRsaSignUpdate(unsigned long long handle, byte* data, int dataLen);
+
+// 计算签名，之后句柄仍需调用goRsaSignFree释放
+ByteArray goRsaSignFinish(unsigned long long handle);
+
+// 释放签名器句柄，句柄类型不符或已释放时返回错误
+BoolResult goRsaSignFree(unsigned long long handle);
+
+// RSA流式验证函数
+
+// 解析公钥并创建验证器，返回句柄
+HandleResult goRsaVerifyCreate(byte* publicKey, int publicKeyLen, int hash, int padding, int saltLength);
+
+// 向验证器追加数据
+BoolResult goRsaVerifyUpdate(unsigned long long handle, byte* data, int dataLen);
+
+// 验证签名，之后句柄仍需调用goRsaVerifyFree释放
+BoolResult goRsaVerifyFinish(unsigned long long handle, byte* signature, int signatureLen);
+
+// 释放验证器句柄，句柄类型不符或已释放时返回错误
+BoolResult goRsaVerifyFree(unsigned long long handle);
+
+// ========= 密钥派生API函数 =========
+
+// 密钥派生函数
+
+// 使用PBKDF2派生密钥，hash为HashAlgorithm，参数为0时使用默认值
+ByteArray goKdfPbkdf2(byte* password, int passwordLen, byte* salt, int saltLen, int iterations, int keyLength, int hash);
+
+// 使用scrypt派生密钥，参数为0时使用默认值，128·N·r·p超过1 GiB时返回错误
+ByteArray goKdfScrypt(byte* password, int passwordLen, byte* salt, int saltLen, int n, int r, int p, int keyLength);
+
+// 使用Argon2id派生密钥，参数为0时使用默认值
+ByteArray goKdfArgon2id(byte* password, int passwordLen, byte* salt, int saltLen, int time, int memory, int threads, int keyLength);
+
+// 使用HKDF派生密钥，hash为HashAlgorithm，length为0时使用32字节
+ByteArray goKdfHkdf(byte* secret, int secretLen, byte* salt, int saltLen, byte* info, int infoLen, int length, int hash);
+
+// 口令哈希函数
+
+// 使用Argon2id计算口令哈希，返回PHC格式字符串
+StringResult goKdfHashPassword(char* password, int time, int memory, int threads);
+
+// 验证口令与PHC格式字符串是否匹配，参数超出限制的字符串会返回错误
+BoolResult goKdfVerifyPassword(char* password, char* encodedHash);
+
+// ========= 随机数API函数 =========
+
+// 生成n个密码学安全的随机字节
+ByteArray goRandomBytes(int n);
+
+// 生成[min, max]区间内均匀分布的随机整数
+IntResult goRandomInt(long long min, long long max);
+
+// 生成n个随机字节并返回URL安全的Base64编码的令牌
+StringResult goRandomToken(int n);
+
+// 生成随机UUID（版本4）
+StringResult goRandomUuidV4(void);
+
+// 生成按时间排序的UUID（版本7）
+StringResult goRandomUuidV7(void);
+
+// ========= JWK API函数 =========
+
+// 将公钥转换为JWK，kid为空时使用RFC 7638指纹
+StringResult goJwkFromPublicKey(byte* publicKey, int publicKeyLen, char* kid);
+
+// 将私钥转换为JWK，kid为空时使用RFC 7638指纹
+StringResult goJwkFromPrivateKey(byte* privateKey, int privateKeyLen, char* kid);
+
+// 将JWK转换为PKIX公钥
+ByteArray goJwkToPublicKey(char* jwk);
+
+// 将私钥JWK转换为PKCS8私钥
+ByteArray goJwkToPrivateKey(char* jwk);
+
+// 计算JWK的RFC 7638指纹
+StringResult goJwkThumbprint(char* jwk);
+
+// 将JWK添加到JWKS，已存在相同kid时返回错误
+StringResult goJwksAddKey(char* jwks, char* jwk);
+
+// 在JWKS中按kid查找JWK
+StringResult goJwksLookupKey(char* jwks, char* kid);
+
+// ========= JWT API函数 =========
+
+// 使用私钥签发JWT，claims为JSON对象，algorithm为RS256、PS256、ES256或EdDSA等，kid可为空
+StringResult goJwtSign(char* claims, byte* privateKey, int privateKeyLen, char* algorithm, char* kid);
+
+// 验证JWT签名和exp、nbf、iss、aud声明并返回JSON格式的声明，algorithm、issuer和audience为空时不限制
+StringResult goJwtVerify(char* token, byte* publicKey, int publicKeyLen, char* algorithm, char* issuer, char* audience, int clockSkewSeconds);
+
+// 解码JWT头部（不验证签名）
+StringResult goJwtDecodeHeader(char* token);
+
+// 解码JWT声明（不验证签名）
+StringResult goJwtDecodeClaims(char* token);
+
 // ========= 内存管理函数 =========
 
 // 释放ByteArray结构分配的内存
@@ -98,6 +869,24 @@ void goFreeByteArray(ByteArray result);
 // 释放RsaKeyPair结构分配的内存
 void goFreeRsaKeyPair(RsaKeyPair result);
 
+// 释放EcdsaKeyPair结构分配的内存
+void goFreeEcdsaKeyPair(EcdsaKeyPair result);
+
+// 释放Ed25519KeyPair结构分配的内存
+void goFreeEd25519KeyPair(Ed25519KeyPair result);
+
+// 释放EcdhKeyPair结构分配的内存
+void goFreeEcdhKeyPair(EcdhKeyPair result);
+
+// 释放Sm2KeyPair结构分配的内存
+void goFreeSm2KeyPair(Sm2KeyPair result);
+
+// 释放HandleResult结构分配的内存
+void goFreeHandleResult(HandleResult result);
+
+// 释放IntResult结构分配的内存
+void goFreeIntResult(IntResult result);
+
 // 释放StringResult结构分配的内存
 void goFreeStringResult(StringResult result);
 
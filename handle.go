@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"sync"
+)
+
+// errInvalidHandle is returned when a streaming handle is unknown, already freed, or of the wrong kind.
+var errInvalidHandle = errors.New("invalid or freed handle")
+
+// 流式计算的句柄表，供cgo和WASM在多次调用之间保存Hasher、Signer和Verifier。
+// 句柄表本身是并发安全的，但同一个句柄不能被并发地Update。
+var (
+	handleMu   sync.Mutex
+	handles    = make(map[uint64]interface{})
+	lastHandle uint64
+)
+
+// newHandle 保存对象并返回新句柄，句柄从1开始，0表示无效句柄
+func newHandle(value interface{}) uint64 {
+	handleMu.Lock()
+	defer handleMu.Unlock()
+
+	lastHandle++
+	handles[lastHandle] = value
+	return lastHandle
+}
+
+// loadHandle 按句柄取出指定类型的对象
+func loadHandle[T any](handle uint64) (T, error) {
+	handleMu.Lock()
+	defer handleMu.Unlock()
+
+	value, ok := handles[handle].(T)
+	if !ok {
+		return value, errInvalidHandle
+	}
+	return value, nil
+}
+
+// freeHandle 释放指定类型的句柄，类型不符、重复释放或无效句柄返回错误且不释放
+func freeHandle[T any](handle uint64) error {
+	handleMu.Lock()
+	defer handleMu.Unlock()
+
+	if _, ok := handles[handle].(T); !ok {
+		return errInvalidHandle
+	}
+	delete(handles, handle)
+	return nil
+}
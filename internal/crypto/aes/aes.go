@@ -0,0 +1,49 @@
+package aes
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+// 支持的密钥长度（位）
+const (
+	KeySize128 = 128
+	KeySize192 = 192
+	KeySize256 = 256
+)
+
+// GenKey generates a random AES key of the given size in bits (128, 192 or 256).
+func GenKey(keySize int) ([]byte, error) {
+	switch keySize {
+	case KeySize128, KeySize192, KeySize256:
+	default:
+		return nil, fmt.Errorf("invalid AES key size: %d", keySize)
+	}
+
+	key := make([]byte, keySize/8)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+	return key, nil
+}
+
+// 创建 AES 分组密码，密钥长度必须为 16、24 或 32 字节
+func newCipher(key []byte) (cipher.Block, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AES key: %w", err)
+	}
+	return block, nil
+}
+
+// 生成指定长度的随机数
+func randomBytes(size int) ([]byte, error) {
+	b := make([]byte, size)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,94 @@
+package aes
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"go-secure-utils/internal/crypto/padding"
+)
+
+// IvSize is the IV length in bytes for CBC and CTR modes.
+const IvSize = aes.BlockSize
+
+// GenIv generates a random 16-byte IV for CBC or CTR mode.
+func GenIv() ([]byte, error) {
+	return randomBytes(IvSize)
+}
+
+// EncryptCbcBase64 encrypts data with AES-CBC and returns the base64 encoded ciphertext.
+func EncryptCbcBase64(data []byte, key []byte, iv []byte, p Padding) (string, error) {
+	encrypted, err := EncryptCbc(data, key, iv, p)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// EncryptCbcHex encrypts data with AES-CBC and returns the hex encoded ciphertext.
+func EncryptCbcHex(data []byte, key []byte, iv []byte, p Padding) (string, error) {
+	encrypted, err := EncryptCbc(data, key, iv, p)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(encrypted), nil
+}
+
+// EncryptCbc encrypts data with AES-CBC using an explicit 16-byte IV.
+// 输出不包含 IV，与 Java 的 "AES/CBC/PKCS5Padding" 兼容。
+func EncryptCbc(data []byte, key []byte, iv []byte, p Padding) ([]byte, error) {
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != IvSize {
+		return nil, fmt.Errorf("invalid IV length: %d", len(iv))
+	}
+
+	// 填充后原地加密
+	padded, err := padding.Pad(data, aes.BlockSize, p)
+	if err != nil {
+		return nil, err
+	}
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(padded, padded)
+	return padded, nil
+}
+
+// DecryptCbcFromBase64 decrypts base64 encoded AES-CBC ciphertext.
+func DecryptCbcFromBase64(encrypted string, key []byte, iv []byte, p Padding) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return DecryptCbc(encryptedData, key, iv, p)
+}
+
+// DecryptCbcFromHex decrypts hex encoded AES-CBC ciphertext.
+func DecryptCbcFromHex(encrypted string, key []byte, iv []byte, p Padding) ([]byte, error) {
+	encryptedData, err := hex.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex: %w", err)
+	}
+	return DecryptCbc(encryptedData, key, iv, p)
+}
+
+// DecryptCbc decrypts AES-CBC ciphertext with an explicit 16-byte IV.
+func DecryptCbc(encryptedData []byte, key []byte, iv []byte, p Padding) ([]byte, error) {
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != IvSize {
+		return nil, fmt.Errorf("invalid IV length: %d", len(iv))
+	}
+	if len(encryptedData)%aes.BlockSize != 0 {
+		return nil, errors.New("ciphertext is not a multiple of the block size")
+	}
+
+	plaintext := make([]byte, len(encryptedData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, encryptedData)
+	return padding.Unpad(plaintext, aes.BlockSize, p)
+}
@@ -0,0 +1,70 @@
+package aes
+
+import (
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// EncryptCtrBase64 encrypts data with AES-CTR and returns the base64 encoded ciphertext.
+func EncryptCtrBase64(data []byte, key []byte, iv []byte) (string, error) {
+	encrypted, err := EncryptCtr(data, key, iv)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// EncryptCtrHex encrypts data with AES-CTR and returns the hex encoded ciphertext.
+func EncryptCtrHex(data []byte, key []byte, iv []byte) (string, error) {
+	encrypted, err := EncryptCtr(data, key, iv)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(encrypted), nil
+}
+
+// EncryptCtr encrypts data with AES-CTR using an explicit 16-byte initial counter block.
+// CTR 模式无需填充，密文与明文等长；同一密钥下 IV 不可重复使用。
+func EncryptCtr(data []byte, key []byte, iv []byte) ([]byte, error) {
+	return xorCtr(data, key, iv)
+}
+
+// DecryptCtrFromBase64 decrypts base64 encoded AES-CTR ciphertext.
+func DecryptCtrFromBase64(encrypted string, key []byte, iv []byte) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return DecryptCtr(encryptedData, key, iv)
+}
+
+// DecryptCtrFromHex decrypts hex encoded AES-CTR ciphertext.
+func DecryptCtrFromHex(encrypted string, key []byte, iv []byte) ([]byte, error) {
+	encryptedData, err := hex.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex: %w", err)
+	}
+	return DecryptCtr(encryptedData, key, iv)
+}
+
+// DecryptCtr decrypts AES-CTR ciphertext with an explicit 16-byte initial counter block.
+func DecryptCtr(encryptedData []byte, key []byte, iv []byte) ([]byte, error) {
+	return xorCtr(encryptedData, key, iv)
+}
+
+// CTR 模式加解密为同一操作
+func xorCtr(data []byte, key []byte, iv []byte) ([]byte, error) {
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != IvSize {
+		return nil, fmt.Errorf("invalid IV length: %d", len(iv))
+	}
+
+	out := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(out, data)
+	return out, nil
+}
@@ -0,0 +1,77 @@
+package aes
+
+import (
+	"crypto/cipher"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// GCM 参数：12 字节随机 nonce，16 字节认证标签
+const (
+	GcmNonceSize = 12
+	GcmTagSize   = 16
+)
+
+// EncryptGcmBase64 encrypts data with AES-GCM and returns the base64 encoded nonce||ciphertext||tag.
+func EncryptGcmBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	encrypted, err := EncryptGcm(data, key, additionalData)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// EncryptGcm encrypts data with AES-GCM using a random nonce.
+// 输出格式为 nonce(12) || ciphertext || tag(16)，additionalData 可为空。
+func EncryptGcm(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	gcm, err := newGcm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := randomBytes(GcmNonceSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// 密文追加在 nonce 之后
+	return gcm.Seal(nonce, nonce, data, additionalData), nil
+}
+
+// DecryptGcmFromBase64 decrypts base64 encoded nonce||ciphertext||tag with AES-GCM.
+func DecryptGcmFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return DecryptGcm(encryptedData, key, additionalData)
+}
+
+// DecryptGcm decrypts nonce||ciphertext||tag produced by EncryptGcm.
+// additionalData 必须与加密时一致。
+func DecryptGcm(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	gcm, err := newGcm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encryptedData) < GcmNonceSize+GcmTagSize {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	nonce := encryptedData[:GcmNonceSize]
+	plaintext, err := gcm.Open(nil, nonce, encryptedData[GcmNonceSize:], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGcm(key []byte) (cipher.AEAD, error) {
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
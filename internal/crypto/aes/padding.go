@@ -0,0 +1,22 @@
+package aes
+
+import (
+	"go-secure-utils/internal/crypto/padding"
+)
+
+// Padding selects the block padding scheme used by CBC mode.
+type Padding = padding.Padding
+
+const (
+	// PaddingPkcs7 pads with N bytes of value N (PKCS#7, same as Java "PKCS5Padding").
+	PaddingPkcs7 = padding.Pkcs7
+	// PaddingZero pads with zero bytes up to the block size; trailing zeros are stripped on decryption.
+	PaddingZero = padding.Zero
+	// PaddingNone requires the data length to be a multiple of the block size.
+	PaddingNone = padding.None
+)
+
+// ParsePadding parses a padding name such as "PKCS7", "PKCS5Padding", "zero" or "NoPadding".
+func ParsePadding(name string) (Padding, error) {
+	return padding.Parse(name)
+}
@@ -0,0 +1,121 @@
+package chacha20poly1305
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// 参数：32 字节密钥，ChaCha20-Poly1305 使用 12 字节 nonce，XChaCha20-Poly1305 使用 24 字节 nonce
+const (
+	KeySize    = chacha20poly1305.KeySize
+	NonceSize  = chacha20poly1305.NonceSize
+	NonceSizeX = chacha20poly1305.NonceSizeX
+	Overhead   = chacha20poly1305.Overhead
+)
+
+// GenKey generates a random 32-byte key.
+func GenKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+	return key, nil
+}
+
+// EncryptBase64 encrypts data with ChaCha20-Poly1305 and returns the base64 encoded nonce||ciphertext||tag.
+func EncryptBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	encrypted, err := Encrypt(data, key, additionalData)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// Encrypt encrypts data with ChaCha20-Poly1305 (RFC 8439) using a random 12-byte nonce.
+// 输出格式为 nonce(12) || ciphertext || tag(16)，additionalData 可为空。
+func Encrypt(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return seal(chacha20poly1305.New, data, key, additionalData)
+}
+
+// DecryptFromBase64 decrypts base64 encoded nonce||ciphertext||tag with ChaCha20-Poly1305.
+func DecryptFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return Decrypt(encryptedData, key, additionalData)
+}
+
+// Decrypt decrypts nonce||ciphertext||tag produced by Encrypt.
+func Decrypt(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return open(chacha20poly1305.New, encryptedData, key, additionalData)
+}
+
+// EncryptXBase64 encrypts data with XChaCha20-Poly1305 and returns the base64 encoded nonce||ciphertext||tag.
+func EncryptXBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	encrypted, err := EncryptX(data, key, additionalData)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// EncryptX encrypts data with XChaCha20-Poly1305 using a random 24-byte nonce.
+// 扩展 nonce 足够长，可安全地随机生成，适合同一密钥加密大量消息。
+func EncryptX(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return seal(chacha20poly1305.NewX, data, key, additionalData)
+}
+
+// DecryptXFromBase64 decrypts base64 encoded nonce||ciphertext||tag with XChaCha20-Poly1305.
+func DecryptXFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return DecryptX(encryptedData, key, additionalData)
+}
+
+// DecryptX decrypts nonce||ciphertext||tag produced by EncryptX.
+func DecryptX(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return open(chacha20poly1305.NewX, encryptedData, key, additionalData)
+}
+
+// 使用随机 nonce 加密，nonce 置于密文之前
+func seal(newAead func([]byte) (cipher.AEAD, error), data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, data, additionalData), nil
+}
+
+// 拆分 nonce 并解密
+func open(newAead func([]byte) (cipher.AEAD, error), encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	if len(encryptedData) < aead.NonceSize()+aead.Overhead() {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	nonce := encryptedData[:aead.NonceSize()]
+	plaintext, err := aead.Open(nil, nonce, encryptedData[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,65 @@
+package ecdh
+
+import (
+	"crypto/ecdh"
+	"fmt"
+	"strings"
+)
+
+// Curve identifies a key agreement curve.
+type Curve int
+
+const (
+	// X25519 is the Curve25519 Diffie-Hellman function (RFC 7748).
+	X25519 Curve = iota + 1
+	// P256 is NIST P-256 (secp256r1, prime256v1).
+	P256
+)
+
+// String returns the standard name of the curve.
+func (c Curve) String() string {
+	switch c {
+	case X25519:
+		return "X25519"
+	case P256:
+		return "P-256"
+	default:
+		return fmt.Sprintf("Curve(%d)", int(c))
+	}
+}
+
+// ParseCurve parses a curve name such as "X25519", "P-256" or "prime256v1".
+func ParseCurve(name string) (Curve, error) {
+	switch strings.NewReplacer("-", "", "_", "").Replace(strings.ToUpper(name)) {
+	case "X25519", "CURVE25519":
+		return X25519, nil
+	case "P256", "SECP256R1", "PRIME256V1":
+		return P256, nil
+	default:
+		return 0, fmt.Errorf("unsupported curve: %s", name)
+	}
+}
+
+// 转换为标准库的 ecdh.Curve
+func (c Curve) ecdhCurve() (ecdh.Curve, error) {
+	switch c {
+	case X25519:
+		return ecdh.X25519(), nil
+	case P256:
+		return ecdh.P256(), nil
+	default:
+		return nil, fmt.Errorf("unsupported curve: %v", c)
+	}
+}
+
+// 根据标准库曲线查找对应的 Curve
+func curveOf(curve ecdh.Curve) (Curve, error) {
+	switch curve {
+	case ecdh.X25519():
+		return X25519, nil
+	case ecdh.P256():
+		return P256, nil
+	default:
+		return 0, fmt.Errorf("unsupported curve: %v", curve)
+	}
+}
@@ -0,0 +1,249 @@
+package ecdh
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"go-secure-utils/internal/crypto/pemutil"
+)
+
+// PEM 块类型
+const (
+	pemTypePrivateKey = "PRIVATE KEY"
+	pemTypePublicKey  = "PUBLIC KEY"
+)
+
+// 原始公钥长度：X25519 为 32 字节，P-256 未压缩点为 65 字节
+const (
+	x25519PublicKeySize = 32
+	p256PublicKeySize   = 65
+)
+
+// EcdhKeyPair represents a pair of ECDH keys.
+type EcdhKeyPair struct {
+	PublicKey  []byte
+	PrivateKey []byte
+}
+
+// GetPublicKeyBase64 returns the base64 encoded public key.
+func (kp *EcdhKeyPair) GetPublicKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PublicKey)
+}
+
+// GetPrivateKeyBase64 returns the base64 encoded private key.
+func (kp *EcdhKeyPair) GetPrivateKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PrivateKey)
+}
+
+// GenKeyPair generates a new ECDH key pair on the given curve.
+// 私钥为 PKCS8 格式，公钥为 PKIX 格式。
+func GenKeyPair(curve Curve) (*EcdhKeyPair, error) {
+	c, err := curve.ecdhCurve()
+	if err != nil {
+		return nil, err
+	}
+
+	// 生成 ECDH 密钥对
+	privateKey, err := c.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// 将私钥转换为 PKCS8 格式
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// 将公钥转换为 PKIX 格式
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(privateKey.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return &EcdhKeyPair{
+		PublicKey:  publicKeyBytes,
+		PrivateKey: privateKeyBytes,
+	}, nil
+}
+
+// ExtractPublicKey extracts the PKIX public key from a private key.
+func ExtractPublicKey(privateKeyBytes []byte) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	// 提取公钥并转换为 PKIX 格式
+	return x509.MarshalPKIXPublicKey(privateKey.PublicKey())
+}
+
+// PublicKeyToRaw returns the raw public key: 32 bytes for X25519, a 65-byte uncompressed point for P-256.
+// 与 WebCrypto 的 "raw" 导出格式一致。
+func PublicKeyToRaw(publicKeyBytes []byte) ([]byte, error) {
+	pub, err := parsePublicKey(publicKeyBytes, nil)
+	if err != nil {
+		return nil, err
+	}
+	return pub.Bytes(), nil
+}
+
+// RawToPublicKey converts a raw X25519 or uncompressed P-256 public key to PKIX DER.
+// 曲线按长度识别。
+func RawToPublicKey(raw []byte) ([]byte, error) {
+	pub, err := parseRawPublicKey(raw, nil)
+	if err != nil {
+		return nil, err
+	}
+	return x509.MarshalPKIXPublicKey(pub)
+}
+
+// PrivateKeyToPem encodes a private key as PKCS#8 "PRIVATE KEY" PEM.
+func PrivateKeyToPem(privateKeyBytes []byte) (string, error) {
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der})), nil
+}
+
+// PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func PublicKeyToPem(publicKeyBytes []byte) (string, error) {
+	pub, err := parsePublicKey(publicKeyBytes, nil)
+	if err != nil {
+		return "", err
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: der})), nil
+}
+
+// SharedSecret computes the raw Diffie-Hellman shared secret between a private key and a peer public key.
+// 共享密钥不应直接用作对称密钥，请使用 DeriveSessionKey 派生。
+func SharedSecret(privateKeyBytes []byte, peerPublicKeyBytes []byte) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析对端公钥，原始格式按私钥所在曲线解析
+	peerPublicKey, err := parsePublicKey(peerPublicKeyBytes, privateKey.Curve())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse peer public key: %w", err)
+	}
+
+	if peerPublicKey.Curve() != privateKey.Curve() {
+		return nil, errors.New("peer public key is on a different curve")
+	}
+
+	return privateKey.ECDH(peerPublicKey)
+}
+
+// 解析私钥，支持PKCS8和SEC1格式，以及对应的PEM格式
+func parsePrivateKey(privateKeyBytes []byte) (*ecdh.PrivateKey, error) {
+	// PEM 格式先解码为 DER
+	privateKeyBytes, err := pemutil.Decode(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先尝试解析 PKCS8 格式的私钥
+	key, err := x509.ParsePKCS8PrivateKey(privateKeyBytes)
+	if err != nil {
+		// PKCS8 解析失败，尝试解析 SEC1 格式
+		ecPrivateKey, err2 := x509.ParseECPrivateKey(privateKeyBytes)
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to parse private key as PKCS8 or SEC1: %w, %w", err, err2)
+		}
+		key = ecPrivateKey
+	}
+
+	var privateKey *ecdh.PrivateKey
+	switch k := key.(type) {
+	case *ecdh.PrivateKey:
+		privateKey = k
+	case *ecdsa.PrivateKey:
+		// P-256 私钥以 ECDSA 形式解析，转换为 ECDH 私钥
+		if privateKey, err = k.ECDH(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("not an ECDH private key")
+	}
+
+	if _, err := curveOf(privateKey.Curve()); err != nil {
+		return nil, err
+	}
+	return privateKey, nil
+}
+
+// 解析公钥，支持PKIX、原始格式，以及对应的PEM格式
+// curve 非空时原始公钥按该曲线解析，否则按长度识别
+func parsePublicKey(publicKeyBytes []byte, curve ecdh.Curve) (*ecdh.PublicKey, error) {
+	// PEM 格式先解码为 DER
+	publicKeyBytes, err := pemutil.Decode(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先尝试解析 PKIX 格式的公钥
+	pubInterface, err := x509.ParsePKIXPublicKey(publicKeyBytes)
+	if err != nil {
+		// PKIX 解析失败，尝试解析原始公钥
+		if pub, err2 := parseRawPublicKey(publicKeyBytes, curve); err2 == nil {
+			return pub, nil
+		}
+		return nil, fmt.Errorf("failed to parse public key as PKIX or raw: %w", err)
+	}
+
+	var pub *ecdh.PublicKey
+	switch k := pubInterface.(type) {
+	case *ecdh.PublicKey:
+		pub = k
+	case *ecdsa.PublicKey:
+		// P-256 公钥以 ECDSA 形式解析，转换为 ECDH 公钥
+		if pub, err = k.ECDH(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("not an ECDH public key")
+	}
+
+	if _, err := curveOf(pub.Curve()); err != nil {
+		return nil, err
+	}
+	return pub, nil
+}
+
+// 解析原始公钥，curve 为空时按长度识别曲线
+func parseRawPublicKey(raw []byte, curve ecdh.Curve) (*ecdh.PublicKey, error) {
+	if curve == nil {
+		switch len(raw) {
+		case x25519PublicKeySize:
+			curve = ecdh.X25519()
+		case p256PublicKeySize:
+			curve = ecdh.P256()
+		default:
+			return nil, fmt.Errorf("invalid raw public key length: %d", len(raw))
+		}
+	}
+	return curve.NewPublicKey(raw)
+}
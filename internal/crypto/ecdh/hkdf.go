@@ -0,0 +1,21 @@
+package ecdh
+
+import (
+	"go-secure-utils/internal/crypto/hash"
+	"go-secure-utils/internal/crypto/kdf"
+)
+
+// DeriveKey derives a symmetric key of the given length from a shared secret using HKDF-SHA256 (RFC 5869).
+// salt 可为空，info 用于区分不同用途的密钥（如 "chat v1 client->server"）。
+func DeriveKey(sharedSecret []byte, salt []byte, info []byte, length int) ([]byte, error) {
+	return kdf.Hkdf(sharedSecret, salt, info, length, hash.SHA256)
+}
+
+// DeriveSessionKey computes the shared secret with the peer and derives a symmetric key from it with HKDF-SHA256.
+func DeriveSessionKey(privateKeyBytes []byte, peerPublicKeyBytes []byte, salt []byte, info []byte, length int) ([]byte, error) {
+	sharedSecret, err := SharedSecret(privateKeyBytes, peerPublicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return DeriveKey(sharedSecret, salt, info, length)
+}
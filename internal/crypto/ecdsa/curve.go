@@ -0,0 +1,102 @@
+package ecdsa
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"fmt"
+	"strings"
+)
+
+// Curve identifies a NIST elliptic curve.
+type Curve int
+
+const (
+	// P256 is NIST P-256 (secp256r1, prime256v1).
+	P256 Curve = iota + 1
+	// P384 is NIST P-384 (secp384r1).
+	P384
+	// P521 is NIST P-521 (secp521r1).
+	P521
+)
+
+// String returns the standard name of the curve.
+func (c Curve) String() string {
+	switch c {
+	case P256:
+		return "P-256"
+	case P384:
+		return "P-384"
+	case P521:
+		return "P-521"
+	default:
+		return fmt.Sprintf("Curve(%d)", int(c))
+	}
+}
+
+// ParseCurve parses a curve name such as "P-256", "secp256r1" or "prime256v1".
+func ParseCurve(name string) (Curve, error) {
+	switch strings.NewReplacer("-", "", "_", "").Replace(strings.ToUpper(name)) {
+	case "P256", "SECP256R1", "PRIME256V1":
+		return P256, nil
+	case "P384", "SECP384R1":
+		return P384, nil
+	case "P521", "SECP521R1":
+		return P521, nil
+	default:
+		return 0, fmt.Errorf("unsupported curve: %s", name)
+	}
+}
+
+// 转换为标准库的 elliptic.Curve
+func (c Curve) ellipticCurve() (elliptic.Curve, error) {
+	switch c {
+	case P256:
+		return elliptic.P256(), nil
+	case P384:
+		return elliptic.P384(), nil
+	case P521:
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unsupported curve: %v", c)
+	}
+}
+
+// 根据标准库曲线查找对应的 Curve
+func curveOf(curve elliptic.Curve) (Curve, error) {
+	switch curve {
+	case elliptic.P256():
+		return P256, nil
+	case elliptic.P384():
+		return P384, nil
+	case elliptic.P521():
+		return P521, nil
+	default:
+		return 0, fmt.Errorf("unsupported curve: %s", curve.Params().Name)
+	}
+}
+
+// 曲线对应的默认摘要算法：P-256 使用 SHA-256，P-384 使用 SHA-384，P-521 使用 SHA-512
+func (c Curve) hash() crypto.Hash {
+	switch c {
+	case P384:
+		return crypto.SHA384
+	case P521:
+		return crypto.SHA512
+	default:
+		return crypto.SHA256
+	}
+}
+
+// 标量的字节长度
+func (c Curve) byteSize() int {
+	switch c {
+	case P384:
+		return 48
+	case P521:
+		return 66
+	default:
+		return 32
+	}
+}
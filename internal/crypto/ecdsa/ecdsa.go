@@ -0,0 +1,258 @@
+package ecdsa
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"go-secure-utils/internal/crypto/pemutil"
+)
+
+// PEM 块类型
+const (
+	pemTypeEcPrivateKey = "EC PRIVATE KEY"
+	pemTypePrivateKey   = "PRIVATE KEY"
+	pemTypePublicKey    = "PUBLIC KEY"
+)
+
+// EcdsaKeyPair represents a pair of ECDSA keys.
+type EcdsaKeyPair struct {
+	PublicKey  []byte
+	PrivateKey []byte
+}
+
+// GetPublicKeyBase64 returns the base64 encoded public key.
+func (kp *EcdsaKeyPair) GetPublicKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PublicKey)
+}
+
+// GetPrivateKeyBase64 returns the base64 encoded private key.
+func (kp *EcdsaKeyPair) GetPrivateKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PrivateKey)
+}
+
+// GenKeyPair generates a new ECDSA key pair on the given curve.
+// 私钥为 PKCS8 格式，公钥为 PKIX 格式。
+func GenKeyPair(curve Curve) (*EcdsaKeyPair, error) {
+	c, err := curve.ellipticCurve()
+	if err != nil {
+		return nil, err
+	}
+
+	// 生成 ECDSA 密钥对
+	privateKey, err := ecdsa.GenerateKey(c, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// 将私钥转换为 PKCS8 格式
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// 将公钥转换为 PKIX 格式
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EcdsaKeyPair{
+		PublicKey:  publicKeyBytes,
+		PrivateKey: privateKeyBytes,
+	}, nil
+}
+
+// ExtractPublicKey extracts the PKIX public key from a private key.
+func ExtractPublicKey(privateKeyBytes []byte) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	// 提取公钥并转换为 PKIX 格式
+	return x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+}
+
+// PublicKeyCurve returns the curve of a public key (PKIX, X.509 certificate, uncompressed point or PEM).
+func PublicKeyCurve(publicKeyBytes []byte) (Curve, error) {
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return 0, err
+	}
+	return curveOf(pub.Curve)
+}
+
+// ConvertPkcs8ToSec1 converts a PKCS#8 encoded private key to SEC 1 ECPrivateKey.
+func ConvertPkcs8ToSec1(pkcs8Bytes []byte) ([]byte, error) {
+	// PEM 格式先解码为 DER
+	pkcs8Bytes, err := pemutil.Decode(pkcs8Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析 PKCS8 格式的私钥
+	privateKey, err := x509.ParsePKCS8PrivateKey(pkcs8Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PKCS8 private key: %w", err)
+	}
+
+	ecPrivateKey, ok := privateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an ECDSA private key")
+	}
+
+	// 转换为 SEC1 格式
+	return x509.MarshalECPrivateKey(ecPrivateKey)
+}
+
+// ConvertSec1ToPkcs8 converts a SEC 1 ECPrivateKey to PKCS#8.
+func ConvertSec1ToPkcs8(sec1Bytes []byte) ([]byte, error) {
+	// PEM 格式先解码为 DER
+	sec1Bytes, err := pemutil.Decode(sec1Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析 SEC1 格式的私钥
+	privateKey, err := x509.ParseECPrivateKey(sec1Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SEC1 private key: %w", err)
+	}
+
+	// 转换为 PKCS8 格式
+	return x509.MarshalPKCS8PrivateKey(privateKey)
+}
+
+// PrivateKeyToPem encodes a PKCS#8 or SEC 1 private key as PEM.
+// SEC1 输出 EC PRIVATE KEY，PKCS8 输出 PRIVATE KEY。
+func PrivateKeyToPem(privateKeyBytes []byte) (string, error) {
+	der, err := pemutil.Decode(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	blockType := pemTypePrivateKey
+	if _, err := x509.ParseECPrivateKey(der); err == nil {
+		blockType = pemTypeEcPrivateKey
+	} else if _, err := parsePrivateKey(der); err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})), nil
+}
+
+// PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func PublicKeyToPem(publicKeyBytes []byte) (string, error) {
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: der})), nil
+}
+
+// 解析私钥，支持PKCS8和SEC1格式，以及对应的PEM格式
+func parsePrivateKey(privateKeyBytes []byte) (*ecdsa.PrivateKey, error) {
+	// PEM 格式先解码为 DER
+	privateKeyBytes, err := pemutil.Decode(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先尝试解析 PKCS8 格式的私钥
+	pkcs8PrivateKey, err := x509.ParsePKCS8PrivateKey(privateKeyBytes)
+	if err != nil {
+		// PKCS8 解析失败，尝试解析 SEC1 格式
+		privateKey, err2 := x509.ParseECPrivateKey(privateKeyBytes)
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to parse private key as PKCS8 or SEC1: %w, %w", err, err2)
+		}
+		return privateKey, nil
+	}
+
+	// 转换为 ECDSA 私钥
+	privateKey, ok := pkcs8PrivateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an ECDSA private key")
+	}
+
+	return privateKey, nil
+}
+
+// 解析公钥，支持PKIX、X.509证书、未压缩点(X9.63)格式，以及对应的PEM格式
+func parsePublicKey(publicKeyBytes []byte) (*ecdsa.PublicKey, error) {
+	// PEM 格式先解码为 DER
+	publicKeyBytes, err := pemutil.Decode(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先尝试解析 PKIX 格式的公钥
+	pubInterface, err := x509.ParsePKIXPublicKey(publicKeyBytes)
+	if err != nil {
+		// PKIX 解析失败，尝试解析未压缩点（如 Secure Enclave 导出的公钥）
+		if pub, err2 := parseUncompressedPoint(publicKeyBytes); err2 == nil {
+			return pub, nil
+		}
+
+		// 再尝试解析 X.509 证书
+		cert, err3 := x509.ParseCertificate(publicKeyBytes)
+		if err3 != nil {
+			return nil, fmt.Errorf("failed to parse public key as PKIX, X9.63 point or certificate: %w", err)
+		}
+		pubInterface = cert.PublicKey
+	}
+
+	pub, ok := pubInterface.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an ECDSA public key")
+	}
+
+	return pub, nil
+}
+
+// 按长度识别曲线并解析 0x04 || X || Y 格式的公钥
+func parseUncompressedPoint(point []byte) (*ecdsa.PublicKey, error) {
+	if len(point) == 0 || point[0] != 4 {
+		return nil, errors.New("not an uncompressed point")
+	}
+
+	var curve ecdh.Curve
+	switch len(point) {
+	case 1 + 2*P256.byteSize():
+		curve = ecdh.P256()
+	case 1 + 2*P384.byteSize():
+		curve = ecdh.P384()
+	case 1 + 2*P521.byteSize():
+		curve = ecdh.P521()
+	default:
+		return nil, errors.New("invalid point length")
+	}
+
+	// 通过 ecdh 校验点在曲线上，再转换为 ecdsa 公钥
+	ecdhPub, err := curve.NewPublicKey(point)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(ecdhPub)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	return pub.(*ecdsa.PublicKey), nil
+}
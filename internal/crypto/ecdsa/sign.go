@@ -0,0 +1,175 @@
+package ecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrVerification is returned when a signature does not match.
+var ErrVerification = errors.New("ecdsa: verification error")
+
+// DER 编码的签名结构
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// SignBase64 signs data with private key and returns base64 encoded DER signature.
+func SignBase64(data string, privateKeyBytes []byte) (string, error) {
+	signature, err := Sign([]byte(data), privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// Sign signs data with private key and returns an ASN.1 DER signature.
+// 摘要算法由曲线决定：P-256 使用 SHA-256，P-384 使用 SHA-384，P-521 使用 SHA-512。
+func Sign(data []byte, privateKeyBytes []byte) ([]byte, error) {
+	signature, _, err := sign(data, privateKeyBytes)
+	return signature, err
+}
+
+// SignRaw signs data with private key and returns a fixed-length r||s signature as used by JOSE and WebCrypto.
+func SignRaw(data []byte, privateKeyBytes []byte) ([]byte, error) {
+	signature, curve, err := sign(data, privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return DerToRawSignature(signature, curve)
+}
+
+// 签名并返回 DER 签名和私钥所在曲线
+func sign(data []byte, privateKeyBytes []byte) ([]byte, Curve, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	curve, err := curveOf(privateKey.Curve)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 计算摘要并签名
+	signature, err := ecdsa.SignASN1(rand.Reader, privateKey, digest(curve, data))
+	if err != nil {
+		return nil, 0, err
+	}
+	return signature, curve, nil
+}
+
+// VerifyFromBase64 verifies base64 encoded DER signature with public key.
+func VerifyFromBase64(data string, publicKeyBytes []byte, signatureBase64 string) (bool, error) {
+	signature, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode base64 signature: %w", err)
+	}
+	return Verify([]byte(data), publicKeyBytes, signature)
+}
+
+// Verify verifies an ASN.1 DER signature with public key.
+func Verify(data []byte, publicKeyBytes []byte, signature []byte) (bool, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return false, err
+	}
+
+	curve, err := curveOf(pub.Curve)
+	if err != nil {
+		return false, err
+	}
+
+	// 验证签名
+	if !ecdsa.VerifyASN1(pub, digest(curve, data), signature) {
+		return false, ErrVerification
+	}
+	return true, nil
+}
+
+// VerifyRaw verifies a fixed-length r||s signature with public key.
+// 签名长度必须等于公钥所在曲线标量长度的两倍。
+func VerifyRaw(data []byte, publicKeyBytes []byte, signature []byte) (bool, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return false, err
+	}
+
+	curve, err := curveOf(pub.Curve)
+	if err != nil {
+		return false, err
+	}
+
+	// 截短或多补零的 r||s 不是合法的定长签名
+	if len(signature) != 2*curve.byteSize() {
+		return false, fmt.Errorf("invalid raw signature length %d for %s", len(signature), curve)
+	}
+	der, err := RawToDerSignature(signature)
+	if err != nil {
+		return false, err
+	}
+
+	// 验证签名
+	if !ecdsa.VerifyASN1(pub, digest(curve, data), der) {
+		return false, ErrVerification
+	}
+	return true, nil
+}
+
+// DerToRawSignature converts an ASN.1 DER signature to fixed-length r||s for the given curve.
+func DerToRawSignature(signature []byte, curve Curve) ([]byte, error) {
+	if curve == 0 {
+		return nil, errors.New("curve is required for raw signature conversion")
+	}
+	if _, err := curve.ellipticCurve(); err != nil {
+		return nil, err
+	}
+
+	var sig ecdsaSignature
+	if rest, err := asn1.Unmarshal(signature, &sig); err != nil {
+		return nil, fmt.Errorf("failed to parse DER signature: %w", err)
+	} else if len(rest) > 0 {
+		return nil, errors.New("trailing data after DER signature")
+	}
+
+	size := curve.byteSize()
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.BitLen() > size*8 || sig.S.BitLen() > size*8 {
+		return nil, errors.New("invalid DER signature")
+	}
+
+	// r 和 s 分别左侧补零到标量长度
+	raw := make([]byte, 2*size)
+	sig.R.FillBytes(raw[:size])
+	sig.S.FillBytes(raw[size:])
+	return raw, nil
+}
+
+// RawToDerSignature converts a fixed-length r||s signature to ASN.1 DER.
+// 长度必须为 P-256、P-384 或 P-521 的签名长度（64、96 或 132 字节）。
+func RawToDerSignature(signature []byte) ([]byte, error) {
+	switch len(signature) {
+	case 2 * P256.byteSize(), 2 * P384.byteSize(), 2 * P521.byteSize():
+	default:
+		return nil, fmt.Errorf("invalid raw signature length: %d", len(signature))
+	}
+
+	size := len(signature) / 2
+	return asn1.Marshal(ecdsaSignature{
+		R: new(big.Int).SetBytes(signature[:size]),
+		S: new(big.Int).SetBytes(signature[size:]),
+	})
+}
+
+// 使用曲线对应的摘要算法计算摘要
+func digest(curve Curve, data []byte) []byte {
+	h := curve.hash().New()
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,246 @@
+package ed25519
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"go-secure-utils/internal/crypto/pemutil"
+)
+
+// PEM 块类型
+const (
+	pemTypePrivateKey = "PRIVATE KEY"
+	pemTypePublicKey  = "PUBLIC KEY"
+)
+
+// ErrVerification is returned when a signature does not match.
+var ErrVerification = errors.New("ed25519: verification error")
+
+// Ed25519KeyPair represents a pair of Ed25519 keys.
+type Ed25519KeyPair struct {
+	PublicKey  []byte
+	PrivateKey []byte
+}
+
+// GetPublicKeyBase64 returns the base64 encoded public key.
+func (kp *Ed25519KeyPair) GetPublicKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PublicKey)
+}
+
+// GetPrivateKeyBase64 returns the base64 encoded private key.
+func (kp *Ed25519KeyPair) GetPrivateKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PrivateKey)
+}
+
+// GenKeyPair generates a new Ed25519 key pair.
+// 私钥为 PKCS8 格式，公钥为 PKIX 格式。
+func GenKeyPair() (*Ed25519KeyPair, error) {
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+	return GenKeyPairFromSeed(seed)
+}
+
+// GenKeyPairFromSeed derives an Ed25519 key pair from a 32-byte seed.
+func GenKeyPairFromSeed(seed []byte) (*Ed25519KeyPair, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid seed length: got %d, want %d", len(seed), ed25519.SeedSize)
+	}
+	privateKey := ed25519.NewKeyFromSeed(seed)
+
+	// 将私钥转换为 PKCS8 格式
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// 将公钥转换为 PKIX 格式
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ed25519KeyPair{
+		PublicKey:  publicKeyBytes,
+		PrivateKey: privateKeyBytes,
+	}, nil
+}
+
+// ExtractPublicKey extracts the PKIX public key from a private key.
+func ExtractPublicKey(privateKeyBytes []byte) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	// 提取公钥并转换为 PKIX 格式
+	return x509.MarshalPKIXPublicKey(privateKey.Public())
+}
+
+// PrivateKeyToSeed returns the raw 32-byte seed of a private key.
+func PrivateKeyToSeed(privateKeyBytes []byte) ([]byte, error) {
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return privateKey.Seed(), nil
+}
+
+// PublicKeyToRaw returns the raw 32-byte public key.
+func PublicKeyToRaw(publicKeyBytes []byte) ([]byte, error) {
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(pub), nil
+}
+
+// RawToPublicKey converts a raw 32-byte public key to PKIX DER.
+func RawToPublicKey(raw []byte) ([]byte, error) {
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key length: got %d, want %d", len(raw), ed25519.PublicKeySize)
+	}
+	return x509.MarshalPKIXPublicKey(ed25519.PublicKey(raw))
+}
+
+// PrivateKeyToPem encodes a private key as PKCS#8 "PRIVATE KEY" PEM.
+func PrivateKeyToPem(privateKeyBytes []byte) (string, error) {
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der})), nil
+}
+
+// PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func PublicKeyToPem(publicKeyBytes []byte) (string, error) {
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: der})), nil
+}
+
+// SignBase64 signs data with private key and returns base64 encoded signature.
+func SignBase64(data string, privateKeyBytes []byte) (string, error) {
+	signature, err := Sign([]byte(data), privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// Sign signs data with private key and returns a 64-byte signature.
+func Sign(data []byte, privateKeyBytes []byte) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ed25519 直接对原文签名，结果是确定性的
+	return ed25519.Sign(privateKey, data), nil
+}
+
+// VerifyFromBase64 verifies base64 encoded signature with public key.
+func VerifyFromBase64(data string, publicKeyBytes []byte, signatureBase64 string) (bool, error) {
+	signature, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode base64 signature: %w", err)
+	}
+	return Verify([]byte(data), publicKeyBytes, signature)
+}
+
+// Verify verifies signature with public key.
+func Verify(data []byte, publicKeyBytes []byte, signature []byte) (bool, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return false, err
+	}
+
+	// 验证签名
+	if !ed25519.Verify(pub, data, signature) {
+		return false, ErrVerification
+	}
+	return true, nil
+}
+
+// 解析私钥，支持PKCS8格式及其PEM格式，以及32字节种子
+func parsePrivateKey(privateKeyBytes []byte) (ed25519.PrivateKey, error) {
+	// 32 字节种子
+	if len(privateKeyBytes) == ed25519.SeedSize {
+		return ed25519.NewKeyFromSeed(privateKeyBytes), nil
+	}
+
+	// PEM 格式先解码为 DER
+	privateKeyBytes, err := pemutil.Decode(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析 PKCS8 格式的私钥
+	pkcs8PrivateKey, err := x509.ParsePKCS8PrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key as PKCS8 or seed: %w", err)
+	}
+
+	privateKey, ok := pkcs8PrivateKey.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an Ed25519 private key")
+	}
+
+	return privateKey, nil
+}
+
+// 解析公钥，支持PKIX、X.509证书格式及其PEM格式，以及32字节原始公钥
+func parsePublicKey(publicKeyBytes []byte) (ed25519.PublicKey, error) {
+	// 32 字节原始公钥
+	if len(publicKeyBytes) == ed25519.PublicKeySize {
+		return ed25519.PublicKey(publicKeyBytes), nil
+	}
+
+	// PEM 格式先解码为 DER
+	publicKeyBytes, err := pemutil.Decode(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先尝试解析 PKIX 格式的公钥
+	pubInterface, err := x509.ParsePKIXPublicKey(publicKeyBytes)
+	if err != nil {
+		// 再尝试解析 X.509 证书
+		cert, err2 := x509.ParseCertificate(publicKeyBytes)
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to parse public key as PKIX, raw key or certificate: %w", err)
+		}
+		pubInterface = cert.PublicKey
+	}
+
+	pub, ok := pubInterface.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("not an Ed25519 public key")
+	}
+
+	return pub, nil
+}
@@ -0,0 +1,152 @@
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// 信封格式:
+//
+//	magic(4) | version(1) | keyAlg(1) | contentAlg(1) | wrappedKeyLen(2, 大端) | wrappedKey | nonce(12) | ciphertext+tag
+//
+// magic 到 nonce 的头部作为 AES-GCM 的附加数据参与认证。
+const (
+	// Version is the current envelope container version.
+	Version = 1
+
+	// KeyAlgRsaOaepSha256 wraps the content key with RSA-OAEP (SHA-256, MGF1-SHA-256).
+	KeyAlgRsaOaepSha256 = 1
+	// ContentAlgAes256Gcm encrypts the payload with AES-256-GCM.
+	ContentAlgAes256Gcm = 1
+
+	contentKeySize = 32
+	nonceSize      = 12
+	fixedHeaderLen = 4 + 1 + 1 + 1 + 2
+)
+
+var magic = [4]byte{'G', 'S', 'U', 'E'}
+
+// 内容密钥的包装参数
+var keyWrapOptions = &internalrsa.OaepOptions{Hash: internalrsa.SHA256}
+
+// SealBase64 encrypts data for the public key and returns the base64 encoded envelope.
+func SealBase64(data []byte, publicKeyBytes []byte) (string, error) {
+	sealed, err := Seal(data, publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Seal encrypts data of any length for the public key.
+// 随机生成 AES-256-GCM 内容密钥加密数据，再用 RSA-OAEP 包装内容密钥。
+func Seal(data []byte, publicKeyBytes []byte) ([]byte, error) {
+	// 生成随机内容密钥
+	contentKey := make([]byte, contentKeySize)
+	if _, err := io.ReadFull(rand.Reader, contentKey); err != nil {
+		return nil, fmt.Errorf("failed to generate content key: %w", err)
+	}
+
+	// 使用公钥包装内容密钥
+	wrappedKey, err := internalrsa.EncryptOaep(contentKey, publicKeyBytes, keyWrapOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap content key: %w", err)
+	}
+	if len(wrappedKey) > 0xFFFF {
+		return nil, errors.New("wrapped key too long")
+	}
+
+	// 构造头部
+	header := make([]byte, 0, fixedHeaderLen+len(wrappedKey)+nonceSize)
+	header = append(header, magic[:]...)
+	header = append(header, Version, KeyAlgRsaOaepSha256, ContentAlgAes256Gcm)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(wrappedKey)))
+	header = append(header, wrappedKey...)
+
+	nonce := make([]byte, nonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	header = append(header, nonce...)
+
+	// 加密数据，头部作为附加数据
+	gcm, err := newGCM(contentKey)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Seal(header, nonce, data, header), nil
+}
+
+// OpenFromBase64 decrypts a base64 encoded envelope with private key.
+func OpenFromBase64(sealed string, privateKeyBytes []byte) ([]byte, error) {
+	sealedData, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return Open(sealedData, privateKeyBytes)
+}
+
+// Open decrypts an envelope produced by Seal with private key.
+func Open(sealed []byte, privateKeyBytes []byte) ([]byte, error) {
+	// 解析头部
+	if len(sealed) < fixedHeaderLen {
+		return nil, errors.New("envelope too short")
+	}
+	if [4]byte(sealed[:4]) != magic {
+		return nil, errors.New("not an envelope")
+	}
+	if version := sealed[4]; version != Version {
+		return nil, fmt.Errorf("unsupported envelope version: %d", version)
+	}
+	if keyAlg := sealed[5]; keyAlg != KeyAlgRsaOaepSha256 {
+		return nil, fmt.Errorf("unsupported key algorithm: %d", keyAlg)
+	}
+	if contentAlg := sealed[6]; contentAlg != ContentAlgAes256Gcm {
+		return nil, fmt.Errorf("unsupported content algorithm: %d", contentAlg)
+	}
+
+	wrappedKeyLen := int(binary.BigEndian.Uint16(sealed[7:fixedHeaderLen]))
+	headerLen := fixedHeaderLen + wrappedKeyLen + nonceSize
+	if len(sealed) < headerLen {
+		return nil, errors.New("envelope too short")
+	}
+	header := sealed[:headerLen]
+	wrappedKey := sealed[fixedHeaderLen : fixedHeaderLen+wrappedKeyLen]
+	nonce := sealed[headerLen-nonceSize : headerLen]
+
+	// 使用私钥解开内容密钥
+	contentKey, err := internalrsa.DecryptOaep(wrappedKey, privateKeyBytes, keyWrapOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap content key: %w", err)
+	}
+	if len(contentKey) != contentKeySize {
+		return nil, errors.New("invalid content key length")
+	}
+
+	// 解密数据
+	gcm, err := newGCM(contentKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, nonce, sealed[headerLen:], header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt content: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,195 @@
+package hash
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha3"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/blake2s"
+)
+
+// Algorithm identifies a hash algorithm.
+type Algorithm int
+
+const (
+	// MD5 is the MD5 hash algorithm. 仅用于兼容旧系统，不应用于安全场景。
+	MD5 Algorithm = iota + 1
+	// SHA1 is the SHA-1 hash algorithm. 仅用于兼容旧系统，不应用于安全场景。
+	SHA1
+	// SHA224 is the SHA-224 hash algorithm.
+	SHA224
+	// SHA256 is the SHA-256 hash algorithm.
+	SHA256
+	// SHA384 is the SHA-384 hash algorithm.
+	SHA384
+	// SHA512 is the SHA-512 hash algorithm.
+	SHA512
+	// SHA512_224 is the SHA-512/224 hash algorithm.
+	SHA512_224
+	// SHA512_256 is the SHA-512/256 hash algorithm.
+	SHA512_256
+	// SHA3_224 is the SHA3-224 hash algorithm.
+	SHA3_224
+	// SHA3_256 is the SHA3-256 hash algorithm.
+	SHA3_256
+	// SHA3_384 is the SHA3-384 hash algorithm.
+	SHA3_384
+	// SHA3_512 is the SHA3-512 hash algorithm.
+	SHA3_512
+	// BLAKE2b_256 is the BLAKE2b-256 hash algorithm.
+	BLAKE2b_256
+	// BLAKE2b_384 is the BLAKE2b-384 hash algorithm.
+	BLAKE2b_384
+	// BLAKE2b_512 is the BLAKE2b-512 hash algorithm.
+	BLAKE2b_512
+	// BLAKE2s_256 is the BLAKE2s-256 hash algorithm.
+	BLAKE2s_256
+)
+
+// String returns the standard name of the hash algorithm.
+func (a Algorithm) String() string {
+	switch a {
+	case MD5:
+		return "MD5"
+	case SHA1:
+		return "SHA-1"
+	case SHA224:
+		return "SHA-224"
+	case SHA256:
+		return "SHA-256"
+	case SHA384:
+		return "SHA-384"
+	case SHA512:
+		return "SHA-512"
+	case SHA512_224:
+		return "SHA-512/224"
+	case SHA512_256:
+		return "SHA-512/256"
+	case SHA3_224:
+		return "SHA3-224"
+	case SHA3_256:
+		return "SHA3-256"
+	case SHA3_384:
+		return "SHA3-384"
+	case SHA3_512:
+		return "SHA3-512"
+	case BLAKE2b_256:
+		return "BLAKE2b-256"
+	case BLAKE2b_384:
+		return "BLAKE2b-384"
+	case BLAKE2b_512:
+		return "BLAKE2b-512"
+	case BLAKE2s_256:
+		return "BLAKE2s-256"
+	default:
+		return fmt.Sprintf("Algorithm(%d)", int(a))
+	}
+}
+
+// ParseAlgorithm parses a hash algorithm name such as "SHA-256", "sha3-512" or "BLAKE2b-256".
+func ParseAlgorithm(name string) (Algorithm, error) {
+	switch strings.NewReplacer("-", "", "_", "", "/", "").Replace(strings.ToUpper(name)) {
+	case "MD5":
+		return MD5, nil
+	case "SHA1":
+		return SHA1, nil
+	case "SHA224":
+		return SHA224, nil
+	case "SHA256":
+		return SHA256, nil
+	case "SHA384":
+		return SHA384, nil
+	case "SHA512":
+		return SHA512, nil
+	case "SHA512224":
+		return SHA512_224, nil
+	case "SHA512256":
+		return SHA512_256, nil
+	case "SHA3224":
+		return SHA3_224, nil
+	case "SHA3256":
+		return SHA3_256, nil
+	case "SHA3384":
+		return SHA3_384, nil
+	case "SHA3512":
+		return SHA3_512, nil
+	case "BLAKE2B256":
+		return BLAKE2b_256, nil
+	case "BLAKE2B384":
+		return BLAKE2b_384, nil
+	case "BLAKE2B512", "BLAKE2B":
+		return BLAKE2b_512, nil
+	case "BLAKE2S256", "BLAKE2S":
+		return BLAKE2s_256, nil
+	default:
+		return 0, fmt.Errorf("unsupported hash algorithm: %s", name)
+	}
+}
+
+// Size returns the digest length in bytes, or 0 for an unsupported algorithm.
+func (a Algorithm) Size() int {
+	newHash, err := a.newHash()
+	if err != nil {
+		return 0
+	}
+	return newHash().Size()
+}
+
+// Func returns the constructor of the underlying hash.Hash, for use with PBKDF2, HKDF and similar constructions.
+func (a Algorithm) Func() (func() hash.Hash, error) {
+	return a.newHash()
+}
+
+// 返回创建对应 hash.Hash 的函数
+func (a Algorithm) newHash() (func() hash.Hash, error) {
+	switch a {
+	case MD5:
+		return md5.New, nil
+	case SHA1:
+		return sha1.New, nil
+	case SHA224:
+		return sha256.New224, nil
+	case SHA256:
+		return sha256.New, nil
+	case SHA384:
+		return sha512.New384, nil
+	case SHA512:
+		return sha512.New, nil
+	case SHA512_224:
+		return sha512.New512_224, nil
+	case SHA512_256:
+		return sha512.New512_256, nil
+	case SHA3_224:
+		return func() hash.Hash { return sha3.New224() }, nil
+	case SHA3_256:
+		return func() hash.Hash { return sha3.New256() }, nil
+	case SHA3_384:
+		return func() hash.Hash { return sha3.New384() }, nil
+	case SHA3_512:
+		return func() hash.Hash { return sha3.New512() }, nil
+	case BLAKE2b_256:
+		return blake2New(blake2b.New256), nil
+	case BLAKE2b_384:
+		return blake2New(blake2b.New384), nil
+	case BLAKE2b_512:
+		return blake2New(blake2b.New512), nil
+	case BLAKE2s_256:
+		return blake2New(blake2s.New256), nil
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm: %v", a)
+	}
+}
+
+// 无密钥的 BLAKE2 构造函数不会返回错误，这里去掉 error 以便用于 HMAC
+func blake2New(newKeyed func(key []byte) (hash.Hash, error)) func() hash.Hash {
+	return func() hash.Hash {
+		h, _ := newKeyed(nil)
+		return h
+	}
+}
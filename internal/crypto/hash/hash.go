@@ -0,0 +1,76 @@
+package hash
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// Sum returns the digest of data.
+func Sum(data []byte, algorithm Algorithm) ([]byte, error) {
+	newHash, err := algorithm.newHash()
+	if err != nil {
+		return nil, err
+	}
+
+	h := newHash()
+	h.Write(data)
+	return h.Sum(nil), nil
+}
+
+// SumHex returns the hex encoded digest of data.
+func SumHex(data []byte, algorithm Algorithm) (string, error) {
+	digest, err := Sum(data, algorithm)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(digest), nil
+}
+
+// SumBase64 returns the base64 encoded digest of data.
+func SumBase64(data []byte, algorithm Algorithm) (string, error) {
+	digest, err := Sum(data, algorithm)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(digest), nil
+}
+
+// Hmac returns the HMAC of data with key.
+func Hmac(data []byte, key []byte, algorithm Algorithm) ([]byte, error) {
+	newHash, err := algorithm.newHash()
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(newHash, key)
+	mac.Write(data)
+	return mac.Sum(nil), nil
+}
+
+// HmacHex returns the hex encoded HMAC of data with key.
+func HmacHex(data []byte, key []byte, algorithm Algorithm) (string, error) {
+	mac, err := Hmac(data, key, algorithm)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(mac), nil
+}
+
+// HmacBase64 returns the base64 encoded HMAC of data with key.
+func HmacBase64(data []byte, key []byte, algorithm Algorithm) (string, error) {
+	mac, err := Hmac(data, key, algorithm)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(mac), nil
+}
+
+// VerifyHmac reports whether mac is the HMAC of data with key, using constant-time comparison.
+func VerifyHmac(data []byte, key []byte, mac []byte, algorithm Algorithm) (bool, error) {
+	expected, err := Hmac(data, key, algorithm)
+	if err != nil {
+		return false, err
+	}
+	return hmac.Equal(expected, mac), nil
+}
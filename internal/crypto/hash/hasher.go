@@ -0,0 +1,74 @@
+package hash
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+)
+
+// Hasher computes a digest or HMAC incrementally.
+// 不是并发安全的，同一个 Hasher 不能在多个 goroutine 中同时使用。
+type Hasher struct {
+	h         hash.Hash
+	algorithm Algorithm
+}
+
+// New creates a Hasher computing the digest with the given algorithm.
+func New(algorithm Algorithm) (*Hasher, error) {
+	newHash, err := algorithm.newHash()
+	if err != nil {
+		return nil, err
+	}
+	return &Hasher{h: newHash(), algorithm: algorithm}, nil
+}
+
+// NewHmac creates a Hasher computing the HMAC with key and the given algorithm.
+func NewHmac(key []byte, algorithm Algorithm) (*Hasher, error) {
+	newHash, err := algorithm.newHash()
+	if err != nil {
+		return nil, err
+	}
+	return &Hasher{h: hmac.New(newHash, key), algorithm: algorithm}, nil
+}
+
+// Algorithm returns the hash algorithm of the Hasher.
+func (h *Hasher) Algorithm() Algorithm {
+	return h.algorithm
+}
+
+// Size returns the length of the final digest in bytes.
+func (h *Hasher) Size() int {
+	return h.h.Size()
+}
+
+// Update appends data to the running digest.
+func (h *Hasher) Update(data []byte) {
+	h.h.Write(data)
+}
+
+// Write implements io.Writer so a Hasher can be used with io.Copy. It never returns an error.
+func (h *Hasher) Write(data []byte) (int, error) {
+	return h.h.Write(data)
+}
+
+// Final returns the digest of all data written so far.
+// 不会改变内部状态，之后仍可继续 Update。
+func (h *Hasher) Final() []byte {
+	return h.h.Sum(nil)
+}
+
+// FinalHex returns the hex encoded digest of all data written so far.
+func (h *Hasher) FinalHex() string {
+	return hex.EncodeToString(h.Final())
+}
+
+// FinalBase64 returns the base64 encoded digest of all data written so far.
+func (h *Hasher) FinalBase64() string {
+	return base64.StdEncoding.EncodeToString(h.Final())
+}
+
+// Reset clears all data written so far. HMAC 的密钥保持不变。
+func (h *Hasher) Reset() {
+	h.h.Reset()
+}
@@ -0,0 +1,209 @@
+package kdf
+
+import (
+	"crypto/hkdf"
+	"crypto/pbkdf2"
+	"fmt"
+	"math"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+
+	"go-secure-utils/internal/crypto/hash"
+)
+
+// 默认参数，参考 OWASP Password Storage Cheat Sheet
+const (
+	DefaultKeyLength        = 32
+	DefaultPbkdf2Iterations = 600000
+	DefaultScryptN          = 1 << 15
+	DefaultScryptR          = 8
+	DefaultScryptP          = 1
+	DefaultArgon2Time       = 2
+	DefaultArgon2Memory     = 19 * 1024
+	DefaultArgon2Threads    = 1
+)
+
+// Limits on the scrypt parameters accepted by Scrypt.
+// scrypt 需要约 128·N·r·p 字节内存，超出限制的参数在派生前即被拒绝，防止内存耗尽或进程崩溃。
+const (
+	MaxScryptN      = 1 << 20
+	MaxScryptMemory = 1 << 30 // 字节，即 1 GiB
+)
+
+// Pbkdf2Options configures Pbkdf2. 为nil或字段为0时使用默认值。
+type Pbkdf2Options struct {
+	// Hash is the PRF hash algorithm. Zero means SHA-256.
+	Hash hash.Algorithm
+	// Iterations is the iteration count. Zero means 600000.
+	Iterations int
+	// KeyLength is the derived key length in bytes. Zero means 32.
+	KeyLength int
+}
+
+// ScryptOptions configures Scrypt. 为nil或字段为0时使用默认值。
+type ScryptOptions struct {
+	// N is the CPU/memory cost, a power of two greater than 1. Zero means 32768.
+	N int
+	// R is the block size. Zero means 8.
+	R int
+	// P is the parallelization. Zero means 1.
+	P int
+	// KeyLength is the derived key length in bytes. Zero means 32.
+	KeyLength int
+}
+
+// Argon2Options configures Argon2id and HashPassword. 为nil或字段为0时使用默认值。
+type Argon2Options struct {
+	// Time is the number of passes over memory. Zero means 2.
+	Time int
+	// Memory is the memory size in KiB. Zero means 19456 (19 MiB).
+	Memory int
+	// Threads is the degree of parallelism, 1 to 255. Zero means 1.
+	Threads int
+	// KeyLength is the derived key length in bytes. Zero means 32.
+	KeyLength int
+}
+
+// Pbkdf2 derives a key from password and salt with PBKDF2 (RFC 8018).
+func Pbkdf2(password []byte, salt []byte, opts *Pbkdf2Options) ([]byte, error) {
+	var o Pbkdf2Options
+	if opts != nil {
+		o = *opts
+	}
+
+	newHash, err := hashOf(o.Hash).Func()
+	if err != nil {
+		return nil, err
+	}
+
+	iterations := withDefault(o.Iterations, DefaultPbkdf2Iterations)
+	if iterations < 0 {
+		return nil, fmt.Errorf("invalid PBKDF2 iterations: %d", iterations)
+	}
+	keyLength, err := keyLengthOf(o.KeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	return pbkdf2.Key(newHash, string(password), salt, iterations, keyLength)
+}
+
+// Scrypt derives a key from password and salt with scrypt (RFC 7914).
+func Scrypt(password []byte, salt []byte, opts *ScryptOptions) ([]byte, error) {
+	var o ScryptOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	keyLength, err := keyLengthOf(o.KeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	n := withDefault(o.N, DefaultScryptN)
+	r := withDefault(o.R, DefaultScryptR)
+	p := withDefault(o.P, DefaultScryptP)
+	if err := CheckScryptParams(n, r, p); err != nil {
+		return nil, err
+	}
+	return scrypt.Key(password, salt, n, r, p, keyLength)
+}
+
+// CheckScryptParams reports an error if N is not a power of two greater than 1 or exceeds MaxScryptN,
+// r or p is less than 1, or 128·N·r·p exceeds MaxScryptMemory.
+func CheckScryptParams(n int, r int, p int) error {
+	switch {
+	case n <= 1 || n&(n-1) != 0:
+		return fmt.Errorf("invalid scrypt N: %d, must be a power of two greater than 1", n)
+	case n > MaxScryptN:
+		return fmt.Errorf("scrypt N %d exceeds limit %d", n, MaxScryptN)
+	case r < 1:
+		return fmt.Errorf("invalid scrypt r: %d", r)
+	case p < 1:
+		return fmt.Errorf("invalid scrypt p: %d", p)
+	}
+
+	// 逐步相除，避免乘积溢出
+	memory := 128 * n
+	if r > MaxScryptMemory/memory || p > MaxScryptMemory/(memory*r) {
+		return fmt.Errorf("scrypt memory 128*N*r*p exceeds limit %d bytes", MaxScryptMemory)
+	}
+	return nil
+}
+
+// Argon2id derives a key from password and salt with Argon2id (RFC 9106).
+func Argon2id(password []byte, salt []byte, opts *Argon2Options) ([]byte, error) {
+	time, memory, threads, keyLength, err := opts.params()
+	if err != nil {
+		return nil, err
+	}
+	return argon2.IDKey(password, salt, time, memory, threads, keyLength), nil
+}
+
+// Hkdf derives a key of the given length from secret with HKDF (RFC 5869).
+// salt 可为空，info 用于区分不同用途的密钥。
+func Hkdf(secret []byte, salt []byte, info []byte, length int, algorithm hash.Algorithm) ([]byte, error) {
+	newHash, err := hashOf(algorithm).Func()
+	if err != nil {
+		return nil, err
+	}
+
+	keyLength, err := keyLengthOf(length)
+	if err != nil {
+		return nil, err
+	}
+
+	return hkdf.Key(newHash, secret, salt, string(info), keyLength)
+}
+
+// 校验并转换为 argon2 包的参数类型
+func (opts *Argon2Options) params() (time uint32, memory uint32, threads uint8, keyLength uint32, err error) {
+	var o Argon2Options
+	if opts != nil {
+		o = *opts
+	}
+
+	t := withDefault(o.Time, DefaultArgon2Time)
+	m := withDefault(o.Memory, DefaultArgon2Memory)
+	p := withDefault(o.Threads, DefaultArgon2Threads)
+	switch {
+	case t < 1 || t > math.MaxUint32:
+		return 0, 0, 0, 0, fmt.Errorf("invalid Argon2 time: %d", t)
+	case p < 1 || p > math.MaxUint8:
+		return 0, 0, 0, 0, fmt.Errorf("invalid Argon2 threads: %d", p)
+	case m < 8*p || m > math.MaxUint32:
+		return 0, 0, 0, 0, fmt.Errorf("invalid Argon2 memory: %d KiB", m)
+	}
+
+	l, err := keyLengthOf(o.KeyLength)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	return uint32(t), uint32(m), uint8(p), uint32(l), nil
+}
+
+// PBKDF2 和 HKDF 使用的摘要算法，0 表示使用默认值 SHA-256
+func hashOf(algorithm hash.Algorithm) hash.Algorithm {
+	if algorithm == 0 {
+		return hash.SHA256
+	}
+	return algorithm
+}
+
+// 0 表示使用默认值
+func withDefault(value int, defaultValue int) int {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// 校验派生密钥长度，0 表示使用默认值
+func keyLengthOf(length int) (int, error) {
+	length = withDefault(length, DefaultKeyLength)
+	if length < 1 || length > math.MaxInt32 {
+		return 0, fmt.Errorf("invalid key length: %d", length)
+	}
+	return length, nil
+}
@@ -0,0 +1,152 @@
+package kdf
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// ErrPasswordMismatch is returned when a password does not match its hash.
+var ErrPasswordMismatch = errors.New("kdf: password does not match")
+
+// PasswordSaltSize is the size of the random salt used by HashPassword.
+const PasswordSaltSize = 16
+
+// Limits on the parameters accepted by HashPassword and VerifyPassword.
+// PHC 字符串来自存储，参数不可信：超出范围的字符串在派生前即被拒绝，防止恶意哈希导致内存耗尽或长时间计算。
+const (
+	MaxPasswordTime      = 16
+	MaxPasswordMemory    = 1 << 20 // KiB，即 1 GiB
+	MaxPasswordThreads   = 16
+	MinPasswordSaltSize  = 8
+	MaxPasswordSaltSize  = 64
+	MinPasswordKeyLength = 16
+	MaxPasswordKeyLength = 64
+)
+
+// PHC 字符串使用不带填充的标准 Base64
+var phcEncoding = base64.RawStdEncoding
+
+// HashPassword hashes password with Argon2id and a random salt,
+// returning a PHC string such as "$argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>".
+func HashPassword(password string, opts *Argon2Options) (string, error) {
+	time, memory, threads, keyLength, err := opts.params()
+	if err != nil {
+		return "", err
+	}
+	if err := checkPasswordParams(time, memory, threads, PasswordSaltSize, keyLength); err != nil {
+		return "", err
+	}
+
+	// 生成随机盐
+	salt := make([]byte, PasswordSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, time, memory, threads, keyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, memory, time, threads,
+		phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+}
+
+// VerifyPassword verifies password against a PHC string produced by HashPassword or other Argon2id implementations.
+func VerifyPassword(password string, encodedHash string) (bool, error) {
+	opts, salt, key, err := parsePhc(encodedHash)
+	if err != nil {
+		return false, err
+	}
+
+	time, memory, threads, keyLength, err := opts.params()
+	if err != nil {
+		return false, err
+	}
+	if err := checkPasswordParams(time, memory, threads, len(salt), keyLength); err != nil {
+		return false, err
+	}
+
+	// 使用相同参数重新计算并以常量时间比较
+	computed := argon2.IDKey([]byte(password), salt, time, memory, threads, keyLength)
+	if subtle.ConstantTimeCompare(computed, key) != 1 {
+		return false, ErrPasswordMismatch
+	}
+	return true, nil
+}
+
+// 校验口令哈希参数是否在允许范围内
+func checkPasswordParams(time uint32, memory uint32, threads uint8, saltLength int, keyLength uint32) error {
+	switch {
+	case time > MaxPasswordTime:
+		return fmt.Errorf("Argon2 time %d exceeds limit %d", time, MaxPasswordTime)
+	case memory > MaxPasswordMemory:
+		return fmt.Errorf("Argon2 memory %d KiB exceeds limit %d KiB", memory, MaxPasswordMemory)
+	case threads > MaxPasswordThreads:
+		return fmt.Errorf("Argon2 threads %d exceeds limit %d", threads, MaxPasswordThreads)
+	case saltLength < MinPasswordSaltSize || saltLength > MaxPasswordSaltSize:
+		return fmt.Errorf("invalid password salt length: %d", saltLength)
+	case keyLength < MinPasswordKeyLength || keyLength > MaxPasswordKeyLength:
+		return fmt.Errorf("invalid password hash length: %d", keyLength)
+	}
+	return nil
+}
+
+// 解析 $argon2id$v=19$m=...,t=...,p=...$salt$hash 格式的字符串
+func parsePhc(encodedHash string) (*Argon2Options, []byte, []byte, error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 || parts[0] != "" {
+		return nil, nil, nil, errors.New("invalid PHC string format")
+	}
+	if parts[1] != "argon2id" {
+		return nil, nil, nil, fmt.Errorf("unsupported password hash algorithm: %s", parts[1])
+	}
+	if parts[2] != "v="+strconv.Itoa(argon2.Version) {
+		return nil, nil, nil, fmt.Errorf("unsupported Argon2 version: %s", parts[2])
+	}
+
+	// 解析参数，允许任意顺序
+	opts := &Argon2Options{}
+	for _, param := range strings.Split(parts[3], ",") {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return nil, nil, nil, fmt.Errorf("invalid Argon2 parameter: %s", param)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return nil, nil, nil, fmt.Errorf("invalid Argon2 parameter: %s", param)
+		}
+		switch name {
+		case "m":
+			opts.Memory = n
+		case "t":
+			opts.Time = n
+		case "p":
+			opts.Threads = n
+		default:
+			return nil, nil, nil, fmt.Errorf("unsupported Argon2 parameter: %s", name)
+		}
+	}
+	if opts.Memory == 0 || opts.Time == 0 || opts.Threads == 0 {
+		return nil, nil, nil, errors.New("missing Argon2 parameter")
+	}
+
+	salt, err := phcEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode salt: %w", err)
+	}
+	key, err := phcEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode hash: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, nil, nil, errors.New("empty password hash")
+	}
+	opts.KeyLength = len(key)
+
+	return opts, salt, key, nil
+}
@@ -0,0 +1,97 @@
+// Package padding implements the block padding schemes shared by the AES and SM4 block modes.
+package padding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Padding selects the block padding scheme used by ECB and CBC modes.
+type Padding int
+
+const (
+	// Pkcs7 pads with N bytes of value N (PKCS#7, same as Java "PKCS5Padding").
+	Pkcs7 Padding = iota
+	// Zero pads with zero bytes up to the block size; trailing zeros are stripped on decryption.
+	Zero
+	// None requires the data length to be a multiple of the block size.
+	None
+)
+
+// String returns the name of the padding scheme.
+func (p Padding) String() string {
+	switch p {
+	case Pkcs7:
+		return "PKCS7"
+	case Zero:
+		return "Zero"
+	case None:
+		return "None"
+	default:
+		return fmt.Sprintf("Padding(%d)", int(p))
+	}
+}
+
+// Parse parses a padding name such as "PKCS7", "PKCS5Padding", "zero" or "NoPadding", ignoring case.
+func Parse(name string) (Padding, error) {
+	switch strings.TrimSuffix(strings.ToUpper(name), "PADDING") {
+	case "PKCS7", "PKCS5":
+		return Pkcs7, nil
+	case "ZERO", "ZEROBYTE":
+		return Zero, nil
+	case "NONE", "NO":
+		return None, nil
+	default:
+		return 0, fmt.Errorf("unsupported padding: %s", name)
+	}
+}
+
+// Pad returns a copy of data padded to a multiple of blockSize.
+func Pad(data []byte, blockSize int, p Padding) ([]byte, error) {
+	switch p {
+	case Pkcs7:
+		n := blockSize - len(data)%blockSize
+		return append(bytes.Clone(data), bytes.Repeat([]byte{byte(n)}, n)...), nil
+	case Zero:
+		if len(data)%blockSize == 0 {
+			return bytes.Clone(data), nil
+		}
+		n := blockSize - len(data)%blockSize
+		return append(bytes.Clone(data), make([]byte, n)...), nil
+	case None:
+		if len(data)%blockSize != 0 {
+			return nil, fmt.Errorf("data length %d is not a multiple of the block size", len(data))
+		}
+		return bytes.Clone(data), nil
+	default:
+		return nil, fmt.Errorf("unsupported padding: %v", p)
+	}
+}
+
+// Unpad removes the padding added by Pad.
+func Unpad(data []byte, blockSize int, p Padding) ([]byte, error) {
+	switch p {
+	case Pkcs7:
+		if len(data) == 0 {
+			return nil, errors.New("invalid padding")
+		}
+		n := int(data[len(data)-1])
+		if n == 0 || n > blockSize || n > len(data) {
+			return nil, errors.New("invalid padding")
+		}
+		for _, b := range data[len(data)-n:] {
+			if int(b) != n {
+				return nil, errors.New("invalid padding")
+			}
+		}
+		return data[:len(data)-n], nil
+	case Zero:
+		return bytes.TrimRight(data, "\x00"), nil
+	case None:
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unsupported padding: %v", p)
+	}
+}
@@ -0,0 +1,37 @@
+// Package pemutil decodes the PEM armor shared by the RSA, ECDSA, Ed25519, ECDH and SM2 key formats.
+package pemutil
+
+import (
+	"bytes"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+var pemPrefix = []byte("-----BEGIN")
+
+// ToDer decodes the first PEM block and returns its DER bytes.
+// 带 DEK-Info 头的传统加密 PEM 不支持，加密私钥请使用 PKCS#8 EncryptedPrivateKeyInfo。
+func ToDer(pemData string) ([]byte, error) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return nil, errors.New("failed to decode PEM block")
+	}
+	if _, ok := block.Headers["DEK-Info"]; ok {
+		return nil, errors.New("encrypted PEM is not supported")
+	}
+	return block.Bytes, nil
+}
+
+// Decode returns the DER bytes of a PEM encoded key, or keyBytes unchanged if it is not PEM.
+func Decode(keyBytes []byte) ([]byte, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(keyBytes), pemPrefix) {
+		return keyBytes, nil
+	}
+
+	der, err := ToDer(string(keyBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PEM: %w", err)
+	}
+	return der, nil
+}
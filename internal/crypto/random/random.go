@@ -0,0 +1,49 @@
+package random
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Bytes returns n cryptographically secure random bytes.
+func Bytes(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("invalid length: %d", n)
+	}
+
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return b, nil
+}
+
+// Int returns a uniformly distributed random integer in the closed range [min, max].
+func Int(min int64, max int64) (int64, error) {
+	if min > max {
+		return 0, errors.New("invalid range: min is greater than max")
+	}
+
+	// 使用 big.Int 计算区间大小，避免 max-min+1 溢出
+	size := new(big.Int).Sub(big.NewInt(max), big.NewInt(min))
+	size.Add(size, big.NewInt(1))
+
+	n, err := rand.Int(rand.Reader, size)
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate random number: %w", err)
+	}
+	return n.Add(n, big.NewInt(min)).Int64(), nil
+}
+
+// Token returns a URL-safe base64 (RFC 4648 §5, no padding) encoding of n random bytes.
+// 32 字节对应 43 个字符，适合作为会话标识或一次性令牌。
+func Token(n int) (string, error) {
+	b, err := Bytes(n)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
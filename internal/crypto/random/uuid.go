@@ -0,0 +1,76 @@
+package random
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// UuidV4 returns a random (version 4) UUID in canonical form, as defined by RFC 9562.
+func UuidV4() (string, error) {
+	b, err := Bytes(16)
+	if err != nil {
+		return "", err
+	}
+	return formatUuid(b, 4), nil
+}
+
+// UuidV7 的单调性状态，RFC 9562 6.2 方法 1：同一毫秒内递增 rand_a 中的 12 位计数器，保证单调递增
+var uuidV7State struct {
+	sync.Mutex
+	lastMilli int64
+	counter   uint16
+}
+
+// UuidV7 returns a time-ordered (version 7) UUID in canonical form, as defined by RFC 9562.
+// 前 48 位为 Unix 毫秒时间戳，随后 12 位为计数器，其余为随机数。
+// 同一进程内生成的 UUID 严格递增，同一毫秒内也按生成顺序排序。
+func UuidV7() (string, error) {
+	b, err := Bytes(16)
+	if err != nil {
+		return "", err
+	}
+
+	uuidV7State.Lock()
+	if milli := time.Now().UnixMilli(); milli > uuidV7State.lastMilli {
+		// 新的毫秒：计数器从随机值开始，最高位为 0 以留出递增空间
+		uuidV7State.lastMilli = milli
+		uuidV7State.counter = binary.BigEndian.Uint16(b[6:8]) & 0x07ff
+	} else {
+		// 同一毫秒或时钟回拨：沿用上次的时间戳并递增计数器，溢出时时间戳加 1
+		uuidV7State.counter++
+		if uuidV7State.counter > 0x0fff {
+			uuidV7State.lastMilli++
+			uuidV7State.counter = 0
+		}
+	}
+	milli, counter := uuidV7State.lastMilli, uuidV7State.counter
+	uuidV7State.Unlock()
+
+	// 写入 48 位大端毫秒时间戳和 12 位计数器，版本号由 formatUuid 写入高 4 位
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(milli))
+	copy(b[:6], ts[2:])
+	binary.BigEndian.PutUint16(b[6:8], counter)
+
+	return formatUuid(b, 7), nil
+}
+
+// 设置版本号和 RFC 9562 变体位，并格式化为 8-4-4-4-12
+func formatUuid(b []byte, version byte) string {
+	b[6] = b[6]&0x0f | version<<4
+	b[8] = b[8]&0x3f | 0x80
+
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], b[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], b[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], b[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], b[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], b[10:])
+	return string(buf)
+}
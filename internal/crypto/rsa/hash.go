@@ -0,0 +1,101 @@
+package rsa
+
+import (
+	"crypto"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha3"
+	_ "crypto/sha512"
+	"fmt"
+	"strings"
+)
+
+// Hash identifies a hash algorithm used by RSA padding schemes.
+type Hash int
+
+const (
+	// SHA1 is the SHA-1 hash algorithm.
+	SHA1 Hash = iota + 1
+	// SHA256 is the SHA-256 hash algorithm.
+	SHA256
+	// SHA512 is the SHA-512 hash algorithm.
+	SHA512
+	// SHA384 is the SHA-384 hash algorithm.
+	SHA384
+	// SHA224 is the SHA-224 hash algorithm.
+	SHA224
+	// SHA3_256 is the SHA3-256 hash algorithm.
+	SHA3_256
+)
+
+// String returns the standard name of the hash algorithm.
+func (h Hash) String() string {
+	switch h {
+	case SHA1:
+		return "SHA-1"
+	case SHA224:
+		return "SHA-224"
+	case SHA256:
+		return "SHA-256"
+	case SHA384:
+		return "SHA-384"
+	case SHA512:
+		return "SHA-512"
+	case SHA3_256:
+		return "SHA3-256"
+	default:
+		return fmt.Sprintf("Hash(%d)", int(h))
+	}
+}
+
+// ParseHash parses a hash algorithm name such as "SHA-256", "sha256" or "SHA3-256".
+func ParseHash(name string) (Hash, error) {
+	switch strings.NewReplacer("-", "", "_", "").Replace(strings.ToUpper(name)) {
+	case "SHA1":
+		return SHA1, nil
+	case "SHA224":
+		return SHA224, nil
+	case "SHA256":
+		return SHA256, nil
+	case "SHA384":
+		return SHA384, nil
+	case "SHA512":
+		return SHA512, nil
+	case "SHA3256":
+		return SHA3_256, nil
+	default:
+		return 0, fmt.Errorf("unsupported hash algorithm: %s", name)
+	}
+}
+
+// 转换为标准库的 crypto.Hash
+func (h Hash) cryptoHash() (crypto.Hash, error) {
+	switch h {
+	case SHA1:
+		return crypto.SHA1, nil
+	case SHA224:
+		return crypto.SHA224, nil
+	case SHA256:
+		return crypto.SHA256, nil
+	case SHA384:
+		return crypto.SHA384, nil
+	case SHA512:
+		return crypto.SHA512, nil
+	case SHA3_256:
+		return crypto.SHA3_256, nil
+	default:
+		return 0, fmt.Errorf("unsupported hash algorithm: %v", h)
+	}
+}
+
+// 计算数据摘要，返回摘要值和对应的 crypto.Hash
+func (h Hash) digest(data []byte) ([]byte, crypto.Hash, error) {
+	cryptoHash, err := h.cryptoHash()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hasher := cryptoHash.New()
+	hasher.Write(data)
+	return hasher.Sum(nil), cryptoHash, nil
+}
@@ -0,0 +1,220 @@
+package rsa
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// PrivateKeyFormat identifies the encoding format of an RSA private key.
+type PrivateKeyFormat int
+
+const (
+	// PrivateKeyFormatPkcs1 is the PKCS#1 RSAPrivateKey format.
+	PrivateKeyFormatPkcs1 PrivateKeyFormat = iota
+	// PrivateKeyFormatPkcs8 is the PKCS#8 PrivateKeyInfo format.
+	PrivateKeyFormatPkcs8
+)
+
+// PublicKeyFormat identifies the encoding format of an RSA public key.
+type PublicKeyFormat int
+
+const (
+	// PublicKeyFormatPkix is the PKIX SubjectPublicKeyInfo format.
+	PublicKeyFormatPkix PublicKeyFormat = iota
+	// PublicKeyFormatPkcs1 is the PKCS#1 RSAPublicKey format.
+	PublicKeyFormatPkcs1
+)
+
+// KeyEncoding identifies how generated key bytes are encoded.
+type KeyEncoding int
+
+const (
+	// KeyEncodingDer outputs raw DER bytes.
+	KeyEncodingDer KeyEncoding = iota
+	// KeyEncodingPem outputs PEM text.
+	KeyEncodingPem
+	// KeyEncodingBase64 outputs standard base64 text of the DER bytes.
+	KeyEncodingBase64
+)
+
+// 默认公钥指数
+const defaultPublicExponent = 65537
+
+// KeyPairOptions configures GenKeyPairWithOptions.
+type KeyPairOptions struct {
+	// KeySize is the modulus size in bits. Zero means 2048.
+	KeySize int
+	// PrivateKeyFormat is the private key format. Zero means PKCS#1.
+	PrivateKeyFormat PrivateKeyFormat
+	// PublicKeyFormat is the public key format. Zero means PKIX.
+	PublicKeyFormat PublicKeyFormat
+	// Encoding is the output encoding. Zero means DER.
+	Encoding KeyEncoding
+	// PublicExponent is the public exponent. Zero means 65537.
+	PublicExponent int
+}
+
+// ParsePrivateKeyFormat parses a private key format name such as "PKCS1" or "PKCS8".
+func ParsePrivateKeyFormat(name string) (PrivateKeyFormat, error) {
+	switch strings.NewReplacer("-", "", "#", "").Replace(strings.ToUpper(name)) {
+	case "PKCS1":
+		return PrivateKeyFormatPkcs1, nil
+	case "PKCS8":
+		return PrivateKeyFormatPkcs8, nil
+	default:
+		return 0, fmt.Errorf("unsupported private key format: %s", name)
+	}
+}
+
+// ParsePublicKeyFormat parses a public key format name such as "PKIX" or "PKCS1".
+func ParsePublicKeyFormat(name string) (PublicKeyFormat, error) {
+	switch strings.NewReplacer("-", "", "#", "").Replace(strings.ToUpper(name)) {
+	case "PKIX", "X509", "SPKI":
+		return PublicKeyFormatPkix, nil
+	case "PKCS1":
+		return PublicKeyFormatPkcs1, nil
+	default:
+		return 0, fmt.Errorf("unsupported public key format: %s", name)
+	}
+}
+
+// ParseKeyEncoding parses a key encoding name such as "DER", "PEM" or "Base64".
+func ParseKeyEncoding(name string) (KeyEncoding, error) {
+	switch strings.ToUpper(name) {
+	case "DER":
+		return KeyEncodingDer, nil
+	case "PEM":
+		return KeyEncodingPem, nil
+	case "BASE64":
+		return KeyEncodingBase64, nil
+	default:
+		return 0, fmt.Errorf("unsupported key encoding: %s", name)
+	}
+}
+
+// GenKeyPairWithOptions generates a new RSA key pair with the given formats, encoding and public exponent.
+func GenKeyPairWithOptions(opts *KeyPairOptions) (*RsaKeyPair, error) {
+	if opts == nil {
+		opts = &KeyPairOptions{}
+	}
+
+	keySize := opts.KeySize
+	if keySize <= 0 {
+		keySize = 2048
+	}
+
+	// 生成 RSA 密钥对
+	privateKey, err := generateKey(keySize, opts.PublicExponent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按指定格式编码私钥
+	var privateKeyBytes []byte
+	privateKeyType := pemTypeRsaPrivateKey
+	switch opts.PrivateKeyFormat {
+	case PrivateKeyFormatPkcs1:
+		privateKeyBytes = x509.MarshalPKCS1PrivateKey(privateKey)
+	case PrivateKeyFormatPkcs8:
+		privateKeyType = pemTypePrivateKey
+		privateKeyBytes, err = x509.MarshalPKCS8PrivateKey(privateKey)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported private key format: %d", opts.PrivateKeyFormat)
+	}
+
+	// 按指定格式编码公钥
+	var publicKeyBytes []byte
+	publicKeyType := pemTypePublicKey
+	switch opts.PublicKeyFormat {
+	case PublicKeyFormatPkix:
+		publicKeyBytes, err = x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+	case PublicKeyFormatPkcs1:
+		publicKeyType = pemTypeRsaPublicKey
+		publicKeyBytes = x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)
+	default:
+		return nil, fmt.Errorf("unsupported public key format: %d", opts.PublicKeyFormat)
+	}
+
+	// 按指定编码输出
+	switch opts.Encoding {
+	case KeyEncodingDer:
+	case KeyEncodingPem:
+		publicKeyBytes = pem.EncodeToMemory(&pem.Block{Type: publicKeyType, Bytes: publicKeyBytes})
+		privateKeyBytes = pem.EncodeToMemory(&pem.Block{Type: privateKeyType, Bytes: privateKeyBytes})
+	case KeyEncodingBase64:
+		publicKeyBytes = []byte(base64.StdEncoding.EncodeToString(publicKeyBytes))
+		privateKeyBytes = []byte(base64.StdEncoding.EncodeToString(privateKeyBytes))
+	default:
+		return nil, fmt.Errorf("unsupported key encoding: %d", opts.Encoding)
+	}
+
+	return &RsaKeyPair{
+		PublicKey:  publicKeyBytes,
+		PrivateKey: privateKeyBytes,
+	}, nil
+}
+
+// 生成指定公钥指数的 RSA 私钥，指数为 0 或 65537 时使用标准库
+func generateKey(keySize int, publicExponent int) (*rsa.PrivateKey, error) {
+	if publicExponent == 0 || publicExponent == defaultPublicExponent {
+		return rsa.GenerateKey(rand.Reader, keySize)
+	}
+	if publicExponent < 3 || publicExponent%2 == 0 {
+		return nil, fmt.Errorf("invalid public exponent: %d", publicExponent)
+	}
+	if keySize < 1024 {
+		return nil, errors.New("RSA key size must be at least 1024 bits")
+	}
+
+	e := big.NewInt(int64(publicExponent))
+	one := big.NewInt(1)
+	for {
+		p, err := rand.Prime(rand.Reader, keySize/2)
+		if err != nil {
+			return nil, err
+		}
+		q, err := rand.Prime(rand.Reader, keySize-keySize/2)
+		if err != nil {
+			return nil, err
+		}
+		if p.Cmp(q) == 0 {
+			continue
+		}
+
+		n := new(big.Int).Mul(p, q)
+		if n.BitLen() != keySize {
+			continue
+		}
+
+		// d = e^-1 mod (p-1)(q-1)，e 与 phi 不互素时重新生成
+		phi := new(big.Int).Mul(new(big.Int).Sub(p, one), new(big.Int).Sub(q, one))
+		d := new(big.Int).ModInverse(e, phi)
+		if d == nil {
+			continue
+		}
+
+		privateKey := &rsa.PrivateKey{
+			PublicKey: rsa.PublicKey{N: n, E: publicExponent},
+			D:         d,
+			Primes:    []*big.Int{p, q},
+		}
+		privateKey.Precompute()
+		if err := privateKey.Validate(); err != nil {
+			return nil, err
+		}
+		return privateKey, nil
+	}
+}
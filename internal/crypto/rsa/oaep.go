@@ -0,0 +1,172 @@
+package rsa
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"io"
+	"math/big"
+)
+
+// OaepOptions configures RSA-OAEP encryption and decryption.
+// Java 的 RSA/ECB/OAEPWithSHA-256AndMGF1Padding 默认 MGF1 使用 SHA-1，
+// 对接时需要设置 Hash 为 SHA256、MGFHash 为 SHA1。
+type OaepOptions struct {
+	// Hash is the hash used for the label digest. Zero means SHA-256.
+	Hash Hash
+	// MGFHash is the hash used by MGF1. Zero means the same as Hash.
+	MGFHash Hash
+	// Label is an optional label that must match between encryption and decryption.
+	Label []byte
+}
+
+// 解析 OAEP 参数，返回摘要算法和 MGF1 摘要算法
+func (opts *OaepOptions) hashes() (crypto.Hash, crypto.Hash, error) {
+	h, mgfHash := SHA256, Hash(0)
+	if opts != nil {
+		if opts.Hash != 0 {
+			h = opts.Hash
+		}
+		mgfHash = opts.MGFHash
+	}
+	if mgfHash == 0 {
+		mgfHash = h
+	}
+
+	oaepHash, err := h.cryptoHash()
+	if err != nil {
+		return 0, 0, err
+	}
+	mgf1Hash, err := mgfHash.cryptoHash()
+	if err != nil {
+		return 0, 0, err
+	}
+	return oaepHash, mgf1Hash, nil
+}
+
+func (opts *OaepOptions) label() []byte {
+	if opts == nil {
+		return nil
+	}
+	return opts.Label
+}
+
+// EncryptOaepBase64 encrypts data with public key using RSA-OAEP and returns base64 encoded result.
+func EncryptOaepBase64(data []byte, publicKeyBytes []byte, opts *OaepOptions) (string, error) {
+	encrypted, err := EncryptOaep(data, publicKeyBytes, opts)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// EncryptOaep encrypts data with public key using RSA-OAEP.
+func EncryptOaep(data []byte, publicKeyBytes []byte, opts *OaepOptions) ([]byte, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	oaepHash, mgf1Hash, err := opts.hashes()
+	if err != nil {
+		return nil, err
+	}
+
+	return encryptOaep(pub, data, oaepHash, mgf1Hash, opts.label())
+}
+
+// DecryptOaepFromBase64 decrypts base64 encoded data with private key using RSA-OAEP.
+func DecryptOaepFromBase64(encrypted string, privateKeyBytes []byte, opts *OaepOptions) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return DecryptOaep(encryptedData, privateKeyBytes, opts)
+}
+
+// DecryptOaep decrypts data with private key using RSA-OAEP.
+func DecryptOaep(encryptedData []byte, privateKeyBytes []byte, opts *OaepOptions) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	oaepHash, mgf1Hash, err := opts.hashes()
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用私钥进行解密
+	return privateKey.Decrypt(rand.Reader, encryptedData, &rsa.OAEPOptions{
+		Hash:    oaepHash,
+		MGFHash: mgf1Hash,
+		Label:   opts.label(),
+	})
+}
+
+// 使用公钥进行 OAEP 加密
+func encryptOaep(pub *rsa.PublicKey, data []byte, oaepHash, mgf1Hash crypto.Hash, label []byte) ([]byte, error) {
+	// 摘要算法与 MGF1 一致时直接使用标准库
+	if oaepHash == mgf1Hash {
+		return rsa.EncryptOAEP(oaepHash.New(), rand.Reader, pub, data, label)
+	}
+	return encryptOaepWithMgf(pub, data, oaepHash, mgf1Hash, label)
+}
+
+// 按 RFC 8017 7.1.1 进行 OAEP 编码并加密，用于摘要算法与 MGF1 不一致的情况
+func encryptOaepWithMgf(pub *rsa.PublicKey, data []byte, oaepHash, mgf1Hash crypto.Hash, label []byte) ([]byte, error) {
+	k := pub.Size()
+	h := oaepHash.New()
+	hLen := h.Size()
+	if len(data) > k-2*hLen-2 {
+		return nil, rsa.ErrMessageTooLong
+	}
+
+	// EM = 0x00 || maskedSeed || maskedDB
+	h.Write(label)
+	em := make([]byte, k)
+	seed := em[1 : 1+hLen]
+	db := em[1+hLen:]
+	h.Sum(db[:0])
+	db[len(db)-len(data)-1] = 1
+	copy(db[len(db)-len(data):], data)
+
+	if _, err := io.ReadFull(rand.Reader, seed); err != nil {
+		return nil, err
+	}
+
+	mgf1XOR(db, mgf1Hash.New(), seed)
+	mgf1XOR(seed, mgf1Hash.New(), db)
+
+	// c = m^e mod n
+	m := new(big.Int).SetBytes(em)
+	c := new(big.Int).Exp(m, big.NewInt(int64(pub.E)), pub.N)
+	return c.FillBytes(make([]byte, k)), nil
+}
+
+// 使用 MGF1 生成掩码并异或到 out
+func mgf1XOR(out []byte, h hash.Hash, seed []byte) {
+	var counter [4]byte
+	done := 0
+	for done < len(out) {
+		h.Reset()
+		h.Write(seed)
+		h.Write(counter[:])
+		digest := h.Sum(nil)
+		for i := 0; i < len(digest) && done < len(out); i++ {
+			out[done] ^= digest[i]
+			done++
+		}
+		for i := len(counter) - 1; i >= 0; i-- {
+			counter[i]++
+			if counter[i] != 0 {
+				break
+			}
+		}
+	}
+}
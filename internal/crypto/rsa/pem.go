@@ -0,0 +1,59 @@
+package rsa
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+
+	"go-secure-utils/internal/crypto/pemutil"
+)
+
+// PEM 块类型
+const (
+	pemTypeRsaPrivateKey = "RSA PRIVATE KEY"
+	pemTypePrivateKey    = "PRIVATE KEY"
+	pemTypeRsaPublicKey  = "RSA PUBLIC KEY"
+	pemTypePublicKey     = "PUBLIC KEY"
+)
+
+// PrivateKeyToPem encodes a PKCS#1 or PKCS#8 private key as PEM.
+// PKCS1 输出 RSA PRIVATE KEY，PKCS8 输出 PRIVATE KEY。
+func PrivateKeyToPem(privateKeyBytes []byte) (string, error) {
+	der, err := pemutil.Decode(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	blockType := pemTypePrivateKey
+	if _, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		blockType = pemTypeRsaPrivateKey
+	} else if _, err := parsePrivateKey(der); err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})), nil
+}
+
+// PublicKeyToPem encodes a PKIX or PKCS#1 public key as PEM.
+// PKIX 输出 PUBLIC KEY，PKCS1 输出 RSA PUBLIC KEY，证书会先提取为 PKIX 公钥。
+func PublicKeyToPem(publicKeyBytes []byte) (string, error) {
+	der, err := pemutil.Decode(publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	blockType := pemTypePublicKey
+	if _, err := x509.ParsePKCS1PublicKey(der); err == nil {
+		blockType = pemTypeRsaPublicKey
+	} else if _, err := x509.ParsePKIXPublicKey(der); err != nil {
+		if der, err = ConvertPublicKeyToPkix(der); err != nil {
+			return "", err
+		}
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})), nil
+}
+
+// PemToDer decodes the first PEM block and returns its DER bytes.
+func PemToDer(pemData string) ([]byte, error) {
+	return pemutil.ToDer(pemData)
+}
@@ -0,0 +1,374 @@
+package rsa
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+
+	internalkdf "go-secure-utils/internal/crypto/kdf"
+	"go-secure-utils/internal/crypto/padding"
+	"go-secure-utils/internal/crypto/pemutil"
+)
+
+// PKCS#8 EncryptedPrivateKeyInfo (RFC 5958)，加密方案为 PBES2 (RFC 8018)，
+// 密钥派生支持 PBKDF2 和 scrypt (RFC 7914)，加密算法支持 AES-CBC。
+
+const pemTypeEncryptedPrivateKey = "ENCRYPTED PRIVATE KEY"
+
+var (
+	oidPbes2  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPbkdf2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidScrypt = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11591, 4, 11}
+
+	oidHmacWithSha1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHmacWithSha224 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 8}
+	oidHmacWithSha256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHmacWithSha384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHmacWithSha512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+
+	oidAes128Cbc = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAes192Cbc = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAes256Cbc = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+type encryptedPrivateKeyInfo struct {
+	EncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedData       []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	Prf            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+type scryptParams struct {
+	Salt                     []byte
+	CostParameter            int
+	BlockSize                int
+	ParallelizationParameter int
+	KeyLength                int `asn1:"optional"`
+}
+
+// KeyDerivation identifies the password-based key derivation function.
+type KeyDerivation int
+
+const (
+	// KeyDerivationPbkdf2 is PBKDF2 with HMAC-SHA-256.
+	KeyDerivationPbkdf2 KeyDerivation = iota
+	// KeyDerivationScrypt is scrypt.
+	KeyDerivationScrypt
+)
+
+// ParseKeyDerivation parses a key derivation function name such as "PBKDF2" or "scrypt".
+func ParseKeyDerivation(name string) (KeyDerivation, error) {
+	switch strings.ToUpper(name) {
+	case "PBKDF2":
+		return KeyDerivationPbkdf2, nil
+	case "SCRYPT":
+		return KeyDerivationScrypt, nil
+	default:
+		return 0, fmt.Errorf("unsupported key derivation: %s", name)
+	}
+}
+
+// 默认参数
+const (
+	defaultPbkdf2Iterations = 100000
+	defaultScryptN          = 1 << 14
+	defaultScryptR          = 8
+	defaultScryptP          = 1
+	pbeSaltSize             = 16
+)
+
+// 加密私钥来自外部，参数不可信：超出范围的迭代次数在派生前即被拒绝，防止长时间计算。
+// scrypt 参数的限制见 kdf.CheckScryptParams。
+const maxPbkdf2Iterations = 10000000
+
+// EncryptPrivateKeyOptions configures EncryptPrivateKey.
+// 加密算法固定为 AES-256-CBC。
+type EncryptPrivateKeyOptions struct {
+	// KeyDerivation is the key derivation function. Zero means PBKDF2-HMAC-SHA256.
+	KeyDerivation KeyDerivation
+	// Iterations is the PBKDF2 iteration count. Zero means 100000.
+	Iterations int
+	// ScryptN, ScryptR and ScryptP are the scrypt cost parameters. Zero means 16384, 8 and 1.
+	ScryptN int
+	ScryptR int
+	ScryptP int
+}
+
+// EncryptPrivateKey encrypts a private key with password as PKCS#8 EncryptedPrivateKeyInfo DER.
+func EncryptPrivateKey(privateKeyBytes []byte, password []byte, opts *EncryptPrivateKeyOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &EncryptPrivateKeyOptions{}
+	}
+
+	// 解析私钥并转换为 PKCS8 格式
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	pkcs8Bytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, pbeSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+
+	// 派生密钥
+	const keyLength = 32
+	var key []byte
+	var kdf pkix.AlgorithmIdentifier
+	switch opts.KeyDerivation {
+	case KeyDerivationPbkdf2:
+		iterations := opts.Iterations
+		if iterations <= 0 {
+			iterations = defaultPbkdf2Iterations
+		}
+		if err := checkPbkdf2Iterations(iterations); err != nil {
+			return nil, err
+		}
+		key, err = pbkdf2.Key(sha256.New, string(password), salt, iterations, keyLength)
+		if err != nil {
+			return nil, err
+		}
+		kdf, err = algorithmIdentifier(oidPbkdf2, pbkdf2Params{
+			Salt:           salt,
+			IterationCount: iterations,
+			Prf:            pkix.AlgorithmIdentifier{Algorithm: oidHmacWithSha256, Parameters: asn1.NullRawValue},
+		})
+	case KeyDerivationScrypt:
+		n, r, p := defaultScryptN, defaultScryptR, defaultScryptP
+		if opts.ScryptN > 0 {
+			n = opts.ScryptN
+		}
+		if opts.ScryptR > 0 {
+			r = opts.ScryptR
+		}
+		if opts.ScryptP > 0 {
+			p = opts.ScryptP
+		}
+		if err := internalkdf.CheckScryptParams(n, r, p); err != nil {
+			return nil, err
+		}
+		key, err = scrypt.Key(password, salt, n, r, p, keyLength)
+		if err != nil {
+			return nil, err
+		}
+		kdf, err = algorithmIdentifier(oidScrypt, scryptParams{
+			Salt:                     salt,
+			CostParameter:            n,
+			BlockSize:                r,
+			ParallelizationParameter: p,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported key derivation: %d", opts.KeyDerivation)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用 AES-256-CBC 加密
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := padding.Pad(pkcs8Bytes, aes.BlockSize, padding.Pkcs7)
+	if err != nil {
+		return nil, err
+	}
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	encryptionScheme, err := algorithmIdentifier(oidAes256Cbc, iv)
+	if err != nil {
+		return nil, err
+	}
+	pbes2, err := algorithmIdentifier(oidPbes2, pbes2Params{
+		KeyDerivationFunc: kdf,
+		EncryptionScheme:  encryptionScheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		EncryptionAlgorithm: pbes2,
+		EncryptedData:       encrypted,
+	})
+}
+
+// EncryptPrivateKeyToPem encrypts a private key with password as "ENCRYPTED PRIVATE KEY" PEM.
+func EncryptPrivateKeyToPem(privateKeyBytes []byte, password []byte, opts *EncryptPrivateKeyOptions) (string, error) {
+	der, err := EncryptPrivateKey(privateKeyBytes, password, opts)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypeEncryptedPrivateKey, Bytes: der})), nil
+}
+
+// DecryptPrivateKey decrypts a PKCS#8 EncryptedPrivateKeyInfo (DER or PEM) with password and returns PKCS#8 DER.
+func DecryptPrivateKey(encryptedKeyBytes []byte, password []byte) ([]byte, error) {
+	// PEM 格式先解码为 DER
+	der, err := pemutil.Decode(encryptedKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var info encryptedPrivateKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse encrypted private key: %w", err)
+	} else if len(rest) > 0 {
+		return nil, errors.New("trailing data after encrypted private key")
+	}
+	if !info.EncryptionAlgorithm.Algorithm.Equal(oidPbes2) {
+		return nil, fmt.Errorf("unsupported encryption algorithm: %v", info.EncryptionAlgorithm.Algorithm)
+	}
+
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(info.EncryptionAlgorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("failed to parse PBES2 parameters: %w", err)
+	}
+
+	// 确定加密算法和密钥长度
+	var keyLength int
+	switch scheme := params.EncryptionScheme.Algorithm; {
+	case scheme.Equal(oidAes128Cbc):
+		keyLength = 16
+	case scheme.Equal(oidAes192Cbc):
+		keyLength = 24
+	case scheme.Equal(oidAes256Cbc):
+		keyLength = 32
+	default:
+		return nil, fmt.Errorf("unsupported encryption scheme: %v", scheme)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, fmt.Errorf("failed to parse IV: %w", err)
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid IV length")
+	}
+
+	// 派生密钥
+	key, err := deriveKey(params.KeyDerivationFunc, password, keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解密并去除填充
+	encrypted := info.EncryptedData
+	if len(encrypted) == 0 || len(encrypted)%aes.BlockSize != 0 {
+		return nil, errors.New("invalid encrypted data length")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	decrypted := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, encrypted)
+	pkcs8Bytes, err := padding.Unpad(decrypted, aes.BlockSize, padding.Pkcs7)
+	if err != nil {
+		return nil, errors.New("failed to decrypt private key: incorrect password")
+	}
+
+	// 校验解密结果
+	if _, err := parsePrivateKey(pkcs8Bytes); err != nil {
+		return nil, errors.New("failed to decrypt private key: incorrect password")
+	}
+	return pkcs8Bytes, nil
+}
+
+// 按 PBES2 的密钥派生参数派生密钥
+func deriveKey(kdf pkix.AlgorithmIdentifier, password []byte, keyLength int) ([]byte, error) {
+	switch {
+	case kdf.Algorithm.Equal(oidPbkdf2):
+		var params pbkdf2Params
+		if _, err := asn1.Unmarshal(kdf.Parameters.FullBytes, &params); err != nil {
+			return nil, fmt.Errorf("failed to parse PBKDF2 parameters: %w", err)
+		}
+		if params.KeyLength != 0 && params.KeyLength != keyLength {
+			return nil, errors.New("PBKDF2 key length does not match encryption scheme")
+		}
+
+		// 未指定 PRF 时默认为 HMAC-SHA1
+		var prf func() hash.Hash
+		switch alg := params.Prf.Algorithm; {
+		case len(alg) == 0, alg.Equal(oidHmacWithSha1):
+			prf = sha1.New
+		case alg.Equal(oidHmacWithSha224):
+			prf = sha256.New224
+		case alg.Equal(oidHmacWithSha256):
+			prf = sha256.New
+		case alg.Equal(oidHmacWithSha384):
+			prf = sha512.New384
+		case alg.Equal(oidHmacWithSha512):
+			prf = sha512.New
+		default:
+			return nil, fmt.Errorf("unsupported PBKDF2 PRF: %v", alg)
+		}
+		if err := checkPbkdf2Iterations(params.IterationCount); err != nil {
+			return nil, err
+		}
+		return pbkdf2.Key(prf, string(password), params.Salt, params.IterationCount, keyLength)
+	case kdf.Algorithm.Equal(oidScrypt):
+		var params scryptParams
+		if _, err := asn1.Unmarshal(kdf.Parameters.FullBytes, &params); err != nil {
+			return nil, fmt.Errorf("failed to parse scrypt parameters: %w", err)
+		}
+		if params.KeyLength != 0 && params.KeyLength != keyLength {
+			return nil, errors.New("scrypt key length does not match encryption scheme")
+		}
+		if err := internalkdf.CheckScryptParams(params.CostParameter, params.BlockSize, params.ParallelizationParameter); err != nil {
+			return nil, err
+		}
+		return scrypt.Key(password, params.Salt, params.CostParameter, params.BlockSize, params.ParallelizationParameter, keyLength)
+	default:
+		return nil, fmt.Errorf("unsupported key derivation function: %v", kdf.Algorithm)
+	}
+}
+
+// 校验 PBKDF2 迭代次数是否在允许范围内
+func checkPbkdf2Iterations(iterations int) error {
+	if iterations < 1 || iterations > maxPbkdf2Iterations {
+		return fmt.Errorf("invalid PBKDF2 iteration count: %d, must be between 1 and %d", iterations, maxPbkdf2Iterations)
+	}
+	return nil
+}
+
+// 构造带参数的算法标识
+func algorithmIdentifier(oid asn1.ObjectIdentifier, params any) (pkix.AlgorithmIdentifier, error) {
+	paramBytes, err := asn1.Marshal(params)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, err
+	}
+	return pkix.AlgorithmIdentifier{Algorithm: oid, Parameters: asn1.RawValue{FullBytes: paramBytes}}, nil
+}
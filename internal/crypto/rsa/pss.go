@@ -0,0 +1,57 @@
+package rsa
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// PssSaltLengthAuto lets signing use the maximum salt length and verification detect it.
+const PssSaltLengthAuto = -1
+
+// PssOptions configures RSASSA-PSS signing and verification.
+// PS256/PS384/PS512 分别对应 Hash 为 SHA256/SHA384/SHA512，盐长度等于摘要长度。
+type PssOptions struct {
+	// Hash is the message digest algorithm. Zero means SHA-256.
+	Hash Hash
+	// SaltLength is the salt length in bytes. Zero means the digest length,
+	// PssSaltLengthAuto means the maximum when signing and auto-detect when verifying.
+	SaltLength int
+}
+
+// 转换为通用签名参数
+func (opts *PssOptions) signOptions() *SignOptions {
+	signOptions := &SignOptions{Padding: PaddingPss}
+	if opts != nil {
+		signOptions.Hash = opts.Hash
+		signOptions.SaltLength = opts.SaltLength
+	}
+	return signOptions
+}
+
+// SignPssBase64 signs data with private key using RSASSA-PSS and returns base64 encoded signature.
+func SignPssBase64(data string, privateKeyBytes []byte, opts *PssOptions) (string, error) {
+	signature, err := SignPss([]byte(data), privateKeyBytes, opts)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// SignPss signs data with private key using RSASSA-PSS.
+func SignPss(data []byte, privateKeyBytes []byte, opts *PssOptions) ([]byte, error) {
+	return SignWithOptions(data, privateKeyBytes, opts.signOptions())
+}
+
+// VerifyPssFromBase64 verifies base64 encoded RSASSA-PSS signature with public key.
+func VerifyPssFromBase64(data string, publicKeyBytes []byte, signatureBase64 string, opts *PssOptions) (bool, error) {
+	signature, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode base64 signature: %w", err)
+	}
+	return VerifyPss([]byte(data), publicKeyBytes, signature, opts)
+}
+
+// VerifyPss verifies RSASSA-PSS signature with public key.
+func VerifyPss(data []byte, publicKeyBytes []byte, signature []byte, opts *PssOptions) (bool, error) {
+	return VerifyWithOptions(data, publicKeyBytes, signature, opts.signOptions())
+}
@@ -1,15 +1,14 @@
 package rsa
 
 import (
-	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
-	"crypto/sha1"
-	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
 	"errors"
 	"fmt"
+
+	"go-secure-utils/internal/crypto/pemutil"
 )
 
 // RsaKeyPair represents a pair of RSA keys.
@@ -68,8 +67,24 @@ func ExtractPublicKey(privateKeyBytes []byte) ([]byte, error) {
 	return publicKeyBytes, nil
 }
 
-// 解析私钥，支持PKCS1和PKCS8格式
+// ParsePrivateKey parses a PKCS#1 or PKCS#8 private key in DER or PEM form.
+func ParsePrivateKey(privateKeyBytes []byte) (*rsa.PrivateKey, error) {
+	return parsePrivateKey(privateKeyBytes)
+}
+
+// ParsePublicKey parses a PKIX, PKCS#1 or X.509 certificate public key in DER or PEM form.
+func ParsePublicKey(publicKeyBytes []byte) (*rsa.PublicKey, error) {
+	return parsePublicKey(publicKeyBytes)
+}
+
+// 解析私钥，支持PKCS1和PKCS8格式，以及对应的PEM格式
 func parsePrivateKey(privateKeyBytes []byte) (*rsa.PrivateKey, error) {
+	// PEM 格式先解码为 DER
+	privateKeyBytes, err := pemutil.Decode(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
 	// 先尝试解析 PKCS1 格式的私钥
 	privateKey, err := x509.ParsePKCS1PrivateKey(privateKeyBytes)
 	if err == nil {
@@ -91,6 +106,39 @@ func parsePrivateKey(privateKeyBytes []byte) (*rsa.PrivateKey, error) {
 	return rsaPrivateKey, nil
 }
 
+// 解析公钥，支持PKIX、PKCS1、X.509证书格式，以及对应的PEM格式
+func parsePublicKey(publicKeyBytes []byte) (*rsa.PublicKey, error) {
+	// PEM 格式先解码为 DER
+	publicKeyBytes, err := pemutil.Decode(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先尝试解析 PKIX 格式的公钥
+	pubInterface, err := x509.ParsePKIXPublicKey(publicKeyBytes)
+	if err != nil {
+		// PKIX 解析失败，尝试解析 PKCS1 格式
+		pub, err2 := x509.ParsePKCS1PublicKey(publicKeyBytes)
+		if err2 == nil {
+			return pub, nil
+		}
+
+		// 再尝试解析 X.509 证书
+		cert, err3 := x509.ParseCertificate(publicKeyBytes)
+		if err3 != nil {
+			return nil, fmt.Errorf("failed to parse public key as PKIX, PKCS1 or certificate: %w, %w, %w", err, err2, err3)
+		}
+		pubInterface = cert.PublicKey
+	}
+
+	pub, ok := pubInterface.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA public key")
+	}
+
+	return pub, nil
+}
+
 // EncryptBase64 encrypts data with public key and returns base64 encoded result.
 func EncryptBase64(data []byte, publicKeyBytes []byte) (string, error) {
 	encrypted, err := Encrypt(data, publicKeyBytes)
@@ -103,14 +151,9 @@ func EncryptBase64(data []byte, publicKeyBytes []byte) (string, error) {
 // Encrypt encrypts data with public key.
 func Encrypt(data []byte, publicKeyBytes []byte) ([]byte, error) {
 	// 解析公钥
-	pubInterface, err := x509.ParsePKIXPublicKey(publicKeyBytes)
+	pub, err := parsePublicKey(publicKeyBytes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse public key: %w", err)
-	}
-
-	pub, ok := pubInterface.(*rsa.PublicKey)
-	if !ok {
-		return nil, errors.New("not an RSA public key")
+		return nil, err
 	}
 
 	// 使用 PKCS1v15 进行加密
@@ -149,32 +192,12 @@ func SignBase64(data string, privateKeyBytes []byte) (string, error) {
 
 // Sign signs data with private key using SHA-256.
 func Sign(data []byte, privateKeyBytes []byte) ([]byte, error) {
-	// 解析私钥
-	privateKey, err := parsePrivateKey(privateKeyBytes)
-	if err != nil {
-		return nil, err
-	}
-
-	// 计算数据的 SHA-256 哈希
-	hashed := sha256.Sum256(data)
-
-	// 使用私钥进行签名
-	return rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hashed[:])
+	return SignWithOptions(data, privateKeyBytes, &SignOptions{Hash: SHA256})
 }
 
 // SignSha1 signs data with private key using SHA-1 hash.
 func SignSha1(data []byte, privateKeyBytes []byte) ([]byte, error) {
-	// 解析私钥
-	privateKey, err := parsePrivateKey(privateKeyBytes)
-	if err != nil {
-		return nil, err
-	}
-
-	// 计算数据的 SHA-1 哈希
-	hashed := sha1.Sum(data)
-
-	// 使用私钥进行签名
-	return rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA1, hashed[:])
+	return SignWithOptions(data, privateKeyBytes, &SignOptions{Hash: SHA1})
 }
 
 // VerifyFromBase64 verifies base64 encoded signature with public key.
@@ -188,48 +211,22 @@ func VerifyFromBase64(data string, publicKeyBytes []byte, signatureBase64 string
 
 // Verify verifies signature with public key using SHA-256.
 func Verify(data []byte, publicKeyBytes []byte, signature []byte) (bool, error) {
-	// 解析公钥
-	pubInterface, err := x509.ParsePKIXPublicKey(publicKeyBytes)
-	if err != nil {
-		return false, fmt.Errorf("failed to parse public key: %w", err)
-	}
-
-	pub, ok := pubInterface.(*rsa.PublicKey)
-	if !ok {
-		return false, errors.New("not an RSA public key")
-	}
-
-	// 计算数据的 SHA-256 哈希
-	hashed := sha256.Sum256(data)
-
-	// 验证签名
-	err = rsa.VerifyPKCS1v15(pub, crypto.SHA256, hashed[:], signature)
-	return err == nil, err
+	return VerifyWithOptions(data, publicKeyBytes, signature, &SignOptions{Hash: SHA256})
 }
 
 // VerifySha1 verifies signature with public key using SHA-1 hash.
 func VerifySha1(data []byte, publicKeyBytes []byte, signature []byte) (bool, error) {
-	// 解析公钥
-	pubInterface, err := x509.ParsePKIXPublicKey(publicKeyBytes)
-	if err != nil {
-		return false, fmt.Errorf("failed to parse public key: %w", err)
-	}
-
-	pub, ok := pubInterface.(*rsa.PublicKey)
-	if !ok {
-		return false, errors.New("not an RSA public key")
-	}
-
-	// 计算数据的 SHA-1 哈希
-	hashed := sha1.Sum(data)
-
-	// 验证签名
-	err = rsa.VerifyPKCS1v15(pub, crypto.SHA1, hashed[:], signature)
-	return err == nil, err
+	return VerifyWithOptions(data, publicKeyBytes, signature, &SignOptions{Hash: SHA1})
 }
 
 // ConvertPkcs8ToPkcs1 converts PKCS#8 encoded key to PKCS#1.
 func ConvertPkcs8ToPkcs1(pkcs8Bytes []byte) ([]byte, error) {
+	// PEM 格式先解码为 DER
+	pkcs8Bytes, err := pemutil.Decode(pkcs8Bytes)
+	if err != nil {
+		return nil, err
+	}
+
 	// 解析 PKCS8 格式的私钥
 	privateKey, err := x509.ParsePKCS8PrivateKey(pkcs8Bytes)
 	if err != nil {
@@ -248,6 +245,12 @@ func ConvertPkcs8ToPkcs1(pkcs8Bytes []byte) ([]byte, error) {
 
 // ConvertPkcs1ToPkcs8 converts PKCS#1 encoded key to PKCS#8.
 func ConvertPkcs1ToPkcs8(pkcs1Bytes []byte) ([]byte, error) {
+	// PEM 格式先解码为 DER
+	pkcs1Bytes, err := pemutil.Decode(pkcs1Bytes)
+	if err != nil {
+		return nil, err
+	}
+
 	// 解析 PKCS1 格式的私钥
 	privateKey, err := x509.ParsePKCS1PrivateKey(pkcs1Bytes)
 	if err != nil {
@@ -257,3 +260,27 @@ func ConvertPkcs1ToPkcs8(pkcs1Bytes []byte) ([]byte, error) {
 	// 转换为 PKCS8 格式
 	return x509.MarshalPKCS8PrivateKey(privateKey)
 }
+
+// ConvertPublicKeyToPkcs1 converts a PKIX, PKCS#1 or certificate encoded public key to PKCS#1 RSAPublicKey.
+func ConvertPublicKeyToPkcs1(publicKeyBytes []byte) ([]byte, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为 PKCS1 格式
+	return x509.MarshalPKCS1PublicKey(pub), nil
+}
+
+// ConvertPublicKeyToPkix converts a PKIX, PKCS#1 or certificate encoded public key to PKIX SubjectPublicKeyInfo.
+func ConvertPublicKeyToPkix(publicKeyBytes []byte) ([]byte, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为 PKIX 格式
+	return x509.MarshalPKIXPublicKey(pub)
+}
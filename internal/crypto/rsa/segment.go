@@ -0,0 +1,148 @@
+package rsa
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// 与 Java/Android 常见的“分段加密”兼容：明文按块长度切分后逐块加密，密文直接拼接。
+// PKCS#1 v1.5 每块明文最长 keySize/8-11 字节，OAEP 为 keySize/8-2*hLen-2 字节，
+// 每块密文固定为 keySize/8 字节。
+
+// EncryptSegmentedBase64 encrypts data of any length block by block with PKCS#1 v1.5 and returns base64 encoded result.
+func EncryptSegmentedBase64(data []byte, publicKeyBytes []byte) (string, error) {
+	encrypted, err := EncryptSegmented(data, publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// EncryptSegmented encrypts data of any length block by block with PKCS#1 v1.5.
+func EncryptSegmented(data []byte, publicKeyBytes []byte) ([]byte, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryptSegments(data, pub.Size(), pub.Size()-11, func(block []byte) ([]byte, error) {
+		return rsa.EncryptPKCS1v15(rand.Reader, pub, block)
+	})
+}
+
+// DecryptSegmentedFromBase64 decrypts base64 encoded block-wise PKCS#1 v1.5 ciphertext with private key.
+func DecryptSegmentedFromBase64(encrypted string, privateKeyBytes []byte) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return DecryptSegmented(encryptedData, privateKeyBytes)
+}
+
+// DecryptSegmented decrypts block-wise PKCS#1 v1.5 ciphertext with private key.
+func DecryptSegmented(encryptedData []byte, privateKeyBytes []byte) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptSegments(encryptedData, privateKey.Size(), func(block []byte) ([]byte, error) {
+		return rsa.DecryptPKCS1v15(rand.Reader, privateKey, block)
+	})
+}
+
+// EncryptOaepSegmentedBase64 encrypts data of any length block by block with RSA-OAEP and returns base64 encoded result.
+func EncryptOaepSegmentedBase64(data []byte, publicKeyBytes []byte, opts *OaepOptions) (string, error) {
+	encrypted, err := EncryptOaepSegmented(data, publicKeyBytes, opts)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// EncryptOaepSegmented encrypts data of any length block by block with RSA-OAEP.
+func EncryptOaepSegmented(data []byte, publicKeyBytes []byte, opts *OaepOptions) ([]byte, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	oaepHash, mgf1Hash, err := opts.hashes()
+	if err != nil {
+		return nil, err
+	}
+
+	blockSize := pub.Size() - 2*oaepHash.Size() - 2
+	return encryptSegments(data, pub.Size(), blockSize, func(block []byte) ([]byte, error) {
+		return encryptOaep(pub, block, oaepHash, mgf1Hash, opts.label())
+	})
+}
+
+// DecryptOaepSegmentedFromBase64 decrypts base64 encoded block-wise RSA-OAEP ciphertext with private key.
+func DecryptOaepSegmentedFromBase64(encrypted string, privateKeyBytes []byte, opts *OaepOptions) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return DecryptOaepSegmented(encryptedData, privateKeyBytes, opts)
+}
+
+// DecryptOaepSegmented decrypts block-wise RSA-OAEP ciphertext with private key.
+func DecryptOaepSegmented(encryptedData []byte, privateKeyBytes []byte, opts *OaepOptions) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	oaepHash, mgf1Hash, err := opts.hashes()
+	if err != nil {
+		return nil, err
+	}
+
+	oaepOptions := &rsa.OAEPOptions{Hash: oaepHash, MGFHash: mgf1Hash, Label: opts.label()}
+	return decryptSegments(encryptedData, privateKey.Size(), func(block []byte) ([]byte, error) {
+		return privateKey.Decrypt(rand.Reader, block, oaepOptions)
+	})
+}
+
+// 按块长度切分明文并逐块加密
+func encryptSegments(data []byte, keySize int, blockSize int, encrypt func([]byte) ([]byte, error)) ([]byte, error) {
+	if blockSize <= 0 {
+		return nil, errors.New("key too small for the padding scheme")
+	}
+
+	result := make([]byte, 0, (len(data)+blockSize-1)/blockSize*keySize)
+	for offset := 0; offset < len(data); offset += blockSize {
+		end := min(offset+blockSize, len(data))
+		encrypted, err := encrypt(data[offset:end])
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt block at offset %d: %w", offset, err)
+		}
+		result = append(result, encrypted...)
+	}
+	return result, nil
+}
+
+// 按密钥长度切分密文并逐块解密
+func decryptSegments(encryptedData []byte, keySize int, decrypt func([]byte) ([]byte, error)) ([]byte, error) {
+	if len(encryptedData)%keySize != 0 {
+		return nil, fmt.Errorf("ciphertext length %d is not a multiple of key size %d", len(encryptedData), keySize)
+	}
+
+	var result []byte
+	for offset := 0; offset < len(encryptedData); offset += keySize {
+		decrypted, err := decrypt(encryptedData[offset : offset+keySize])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt block at offset %d: %w", offset, err)
+		}
+		result = append(result, decrypted...)
+	}
+	return result, nil
+}
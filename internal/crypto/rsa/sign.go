@@ -0,0 +1,205 @@
+package rsa
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"strings"
+)
+
+// Padding identifies an RSA signature padding scheme.
+type Padding int
+
+const (
+	// PaddingPkcs1v15 is RSASSA-PKCS1-v1_5 padding.
+	PaddingPkcs1v15 Padding = iota
+	// PaddingPss is RSASSA-PSS padding.
+	PaddingPss
+)
+
+// String returns the name of the padding scheme.
+func (p Padding) String() string {
+	switch p {
+	case PaddingPkcs1v15:
+		return "PKCS1v15"
+	case PaddingPss:
+		return "PSS"
+	default:
+		return fmt.Sprintf("Padding(%d)", int(p))
+	}
+}
+
+// ParsePadding parses a padding scheme name such as "PKCS1v15" or "PSS".
+func ParsePadding(name string) (Padding, error) {
+	switch strings.NewReplacer("-", "", "_", "", " ", "").Replace(strings.ToUpper(name)) {
+	case "PKCS1V15", "PKCS1", "PKCS1V1.5":
+		return PaddingPkcs1v15, nil
+	case "PSS":
+		return PaddingPss, nil
+	default:
+		return 0, fmt.Errorf("unsupported padding scheme: %s", name)
+	}
+}
+
+// SignOptions configures SignWithOptions and VerifyWithOptions.
+type SignOptions struct {
+	// Hash is the message digest algorithm. Zero means SHA-256.
+	Hash Hash
+	// Padding is the signature padding scheme. Zero means PKCS#1 v1.5.
+	Padding Padding
+	// SaltLength is the PSS salt length, see PssOptions.SaltLength.
+	SaltLength int
+}
+
+func (opts *SignOptions) hash() Hash {
+	if opts == nil || opts.Hash == 0 {
+		return SHA256
+	}
+	return opts.Hash
+}
+
+func (opts *SignOptions) padding() Padding {
+	if opts == nil {
+		return PaddingPkcs1v15
+	}
+	return opts.Padding
+}
+
+// 转换为标准库的 PSS 参数
+func (opts *SignOptions) pssOptions() (*rsa.PSSOptions, error) {
+	saltLength := 0
+	if opts != nil {
+		saltLength = opts.SaltLength
+	}
+
+	switch {
+	case saltLength == 0:
+		return &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}, nil
+	case saltLength == PssSaltLengthAuto:
+		return &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto}, nil
+	case saltLength > 0:
+		return &rsa.PSSOptions{SaltLength: saltLength}, nil
+	default:
+		return nil, fmt.Errorf("invalid PSS salt length: %d", saltLength)
+	}
+}
+
+// SignWithOptions signs data with private key using the given hash and padding scheme.
+func SignWithOptions(data []byte, privateKeyBytes []byte, opts *SignOptions) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 计算数据的哈希
+	hashed, cryptoHash, err := opts.hash().digest(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用私钥进行签名
+	return signHashed(privateKey, cryptoHash, hashed, opts)
+}
+
+// VerifyWithOptions verifies signature with public key using the given hash and padding scheme.
+func VerifyWithOptions(data []byte, publicKeyBytes []byte, signature []byte, opts *SignOptions) (bool, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return false, err
+	}
+
+	// 计算数据的哈希
+	hashed, cryptoHash, err := opts.hash().digest(data)
+	if err != nil {
+		return false, err
+	}
+
+	// 验证签名
+	err = verifyHashed(pub, cryptoHash, hashed, signature, opts)
+	return err == nil, err
+}
+
+// SignDigest signs an already-computed digest with private key.
+// opts.Hash 指定摘要所用的算法，digest 长度必须与之匹配。
+func SignDigest(digest []byte, privateKeyBytes []byte, opts *SignOptions) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验摘要长度
+	cryptoHash, err := checkDigest(digest, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用私钥进行签名
+	return signHashed(privateKey, cryptoHash, digest, opts)
+}
+
+// VerifyDigest verifies signature of an already-computed digest with public key.
+func VerifyDigest(digest []byte, publicKeyBytes []byte, signature []byte, opts *SignOptions) (bool, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return false, err
+	}
+
+	// 校验摘要长度
+	cryptoHash, err := checkDigest(digest, opts)
+	if err != nil {
+		return false, err
+	}
+
+	// 验证签名
+	err = verifyHashed(pub, cryptoHash, digest, signature, opts)
+	return err == nil, err
+}
+
+// 校验摘要长度与摘要算法是否匹配
+func checkDigest(digest []byte, opts *SignOptions) (crypto.Hash, error) {
+	cryptoHash, err := opts.hash().cryptoHash()
+	if err != nil {
+		return 0, err
+	}
+	if len(digest) != cryptoHash.Size() {
+		return 0, fmt.Errorf("invalid %v digest length: got %d, want %d", opts.hash(), len(digest), cryptoHash.Size())
+	}
+	return cryptoHash, nil
+}
+
+// 按填充方式对摘要签名
+func signHashed(privateKey *rsa.PrivateKey, cryptoHash crypto.Hash, hashed []byte, opts *SignOptions) ([]byte, error) {
+	switch padding := opts.padding(); padding {
+	case PaddingPkcs1v15:
+		return rsa.SignPKCS1v15(rand.Reader, privateKey, cryptoHash, hashed)
+	case PaddingPss:
+		pssOptions, err := opts.pssOptions()
+		if err != nil {
+			return nil, err
+		}
+		return rsa.SignPSS(rand.Reader, privateKey, cryptoHash, hashed, pssOptions)
+	default:
+		return nil, fmt.Errorf("unsupported padding scheme: %v", padding)
+	}
+}
+
+// 按填充方式验证摘要签名
+func verifyHashed(pub *rsa.PublicKey, cryptoHash crypto.Hash, hashed []byte, signature []byte, opts *SignOptions) error {
+	switch padding := opts.padding(); padding {
+	case PaddingPkcs1v15:
+		return rsa.VerifyPKCS1v15(pub, cryptoHash, hashed, signature)
+	case PaddingPss:
+		pssOptions, err := opts.pssOptions()
+		if err != nil {
+			return err
+		}
+		return rsa.VerifyPSS(pub, cryptoHash, hashed, signature, pssOptions)
+	default:
+		return fmt.Errorf("unsupported padding scheme: %v", padding)
+	}
+}
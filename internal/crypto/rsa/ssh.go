@@ -0,0 +1,133 @@
+package rsa
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// PublicKeyToOpenSsh converts a public key to an OpenSSH authorized_keys line such as "ssh-rsa AAAA... comment".
+func PublicKeyToOpenSsh(publicKeyBytes []byte, comment string) (string, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+
+	// MarshalAuthorizedKey 的结果带换行符
+	line := strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(sshPub)), "\n")
+	if comment != "" {
+		line += " " + comment
+	}
+	return line, nil
+}
+
+// OpenSshToPublicKey parses an OpenSSH authorized_keys line and returns the public key as PKIX DER.
+func OpenSshToPublicKey(authorizedKey string) ([]byte, error) {
+	pub, err := parseOpenSshPublicKey([]byte(authorizedKey))
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为 PKIX 格式
+	return x509.MarshalPKIXPublicKey(pub)
+}
+
+// PrivateKeyToOpenSsh encodes a private key as "OPENSSH PRIVATE KEY" PEM.
+// passphrase 非空时使用 bcrypt + AES-256-CTR 加密。
+func PrivateKeyToOpenSsh(privateKeyBytes []byte, passphrase []byte, comment string) (string, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	var block *pem.Block
+	if len(passphrase) == 0 {
+		block, err = ssh.MarshalPrivateKey(privateKey, comment)
+	} else {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(privateKey, comment, passphrase)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(block)), nil
+}
+
+// OpenSshToPrivateKey parses an "OPENSSH PRIVATE KEY" PEM and returns the private key as PKCS#1 DER.
+// 未加密的私钥 passphrase 传空即可。
+func OpenSshToPrivateKey(pemData string, passphrase []byte) ([]byte, error) {
+	var key interface{}
+	var err error
+	if len(passphrase) == 0 {
+		key, err = ssh.ParseRawPrivateKey([]byte(pemData))
+	} else {
+		key, err = ssh.ParseRawPrivateKeyWithPassphrase([]byte(pemData), passphrase)
+	}
+	if err != nil {
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) {
+			return nil, errors.New("private key is encrypted, passphrase required")
+		}
+		return nil, fmt.Errorf("failed to parse OpenSSH private key: %w", err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an RSA private key")
+	}
+
+	// 转换为 PKCS1 格式
+	return x509.MarshalPKCS1PrivateKey(privateKey), nil
+}
+
+// OpenSshFingerprint returns the "SHA256:..." fingerprint of a public key as printed by "ssh-keygen -l".
+// 公钥可以是 DER、PEM 或 authorized_keys 格式。
+func OpenSshFingerprint(publicKeyBytes []byte) (string, error) {
+	var pub *rsa.PublicKey
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(publicKeyBytes), []byte(ssh.KeyAlgoRSA+" ")) {
+		pub, err = parseOpenSshPublicKey(publicKeyBytes)
+	} else {
+		pub, err = parsePublicKey(publicKeyBytes)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	return ssh.FingerprintSHA256(sshPub), nil
+}
+
+// 解析 authorized_keys 格式的 RSA 公钥
+func parseOpenSshPublicKey(authorizedKey []byte) (*rsa.PublicKey, error) {
+	sshPub, _, _, _, err := ssh.ParseAuthorizedKey(authorizedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenSSH public key: %w", err)
+	}
+
+	cryptoPub, ok := sshPub.(ssh.CryptoPublicKey)
+	if !ok {
+		return nil, errors.New("unsupported OpenSSH public key")
+	}
+	pub, ok := cryptoPub.CryptoPublicKey().(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA public key")
+	}
+	return pub, nil
+}
@@ -0,0 +1,98 @@
+package rsa
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"hash"
+)
+
+// Signer signs data written in chunks, so large inputs never need to be held in memory at once.
+// 不是并发安全的，同一个 Signer 不能在多个 goroutine 中同时使用。
+type Signer struct {
+	privateKey *rsa.PrivateKey
+	cryptoHash crypto.Hash
+	h          hash.Hash
+	opts       *SignOptions
+}
+
+// NewSigner creates a Signer for the given private key, hash and padding scheme.
+func NewSigner(privateKeyBytes []byte, opts *SignOptions) (*Signer, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoHash, err := opts.hash().cryptoHash()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		privateKey: privateKey,
+		cryptoHash: cryptoHash,
+		h:          cryptoHash.New(),
+		opts:       opts,
+	}, nil
+}
+
+// Update appends data to the content being signed.
+func (s *Signer) Update(data []byte) {
+	s.h.Write(data)
+}
+
+// Write implements io.Writer so a Signer can be used with io.Copy. It never returns an error.
+func (s *Signer) Write(data []byte) (int, error) {
+	return s.h.Write(data)
+}
+
+// Sign returns the signature of all data written so far.
+func (s *Signer) Sign() ([]byte, error) {
+	return signHashed(s.privateKey, s.cryptoHash, s.h.Sum(nil), s.opts)
+}
+
+// Verifier verifies a signature over data written in chunks.
+// 不是并发安全的，同一个 Verifier 不能在多个 goroutine 中同时使用。
+type Verifier struct {
+	publicKey  *rsa.PublicKey
+	cryptoHash crypto.Hash
+	h          hash.Hash
+	opts       *SignOptions
+}
+
+// NewVerifier creates a Verifier for the given public key, hash and padding scheme.
+func NewVerifier(publicKeyBytes []byte, opts *SignOptions) (*Verifier, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoHash, err := opts.hash().cryptoHash()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Verifier{
+		publicKey:  pub,
+		cryptoHash: cryptoHash,
+		h:          cryptoHash.New(),
+		opts:       opts,
+	}, nil
+}
+
+// Update appends data to the content being verified.
+func (v *Verifier) Update(data []byte) {
+	v.h.Write(data)
+}
+
+// Write implements io.Writer so a Verifier can be used with io.Copy. It never returns an error.
+func (v *Verifier) Write(data []byte) (int, error) {
+	return v.h.Write(data)
+}
+
+// Verify verifies signature over all data written so far.
+func (v *Verifier) Verify(signature []byte) (bool, error) {
+	err := verifyHashed(v.publicKey, v.cryptoHash, v.h.Sum(nil), signature, v.opts)
+	return err == nil, err
+}
@@ -0,0 +1,108 @@
+package sm2
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/emmansun/gmsm/sm2"
+)
+
+// CiphertextOrder selects how the ciphertext components are concatenated.
+type CiphertextOrder int
+
+const (
+	// C1C3C2 is the order defined by GB/T 32918.4-2016.
+	C1C3C2 CiphertextOrder = iota
+	// C1C2C3 is the order of the 2010 draft, still used by many legacy systems.
+	C1C2C3
+)
+
+// String returns the name of the ciphertext order.
+func (o CiphertextOrder) String() string {
+	switch o {
+	case C1C3C2:
+		return "C1C3C2"
+	case C1C2C3:
+		return "C1C2C3"
+	default:
+		return fmt.Sprintf("CiphertextOrder(%d)", int(o))
+	}
+}
+
+// ParseCiphertextOrder parses "C1C3C2" or "C1C2C3".
+func ParseCiphertextOrder(name string) (CiphertextOrder, error) {
+	switch strings.ToUpper(name) {
+	case "C1C3C2":
+		return C1C3C2, nil
+	case "C1C2C3":
+		return C1C2C3, nil
+	default:
+		return 0, fmt.Errorf("unsupported ciphertext order: %s", name)
+	}
+}
+
+// EncryptBase64 encrypts data with public key and returns the base64 encoded ciphertext.
+func EncryptBase64(data []byte, publicKeyBytes []byte, order CiphertextOrder) (string, error) {
+	encrypted, err := Encrypt(data, publicKeyBytes, order)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// Encrypt encrypts data with public key.
+// 输出为 04 || x || y 开头的非 ASN.1 密文，C2 与 C3 按 order 拼接。
+func Encrypt(data []byte, publicKeyBytes []byte, order CiphertextOrder) ([]byte, error) {
+	opts, _, err := cipherOpts(order)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm2.Encrypt(rand.Reader, pub, data, opts)
+}
+
+// DecryptFromBase64 decrypts base64 encoded ciphertext with private key.
+func DecryptFromBase64(encrypted string, privateKeyBytes []byte, order CiphertextOrder) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return Decrypt(encryptedData, privateKeyBytes, order)
+}
+
+// Decrypt decrypts ciphertext with private key.
+// 同时支持 OpenSSL 输出的 ASN.1 格式密文，此时忽略 order。
+func Decrypt(encryptedData []byte, privateKeyBytes []byte, order CiphertextOrder) ([]byte, error) {
+	_, opts, err := cipherOpts(order)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKey.Decrypt(nil, encryptedData, opts)
+}
+
+// 转换为 gmsm 的加解密参数
+func cipherOpts(order CiphertextOrder) (*sm2.EncrypterOpts, *sm2.DecrypterOpts, error) {
+	switch order {
+	case C1C3C2:
+		return sm2.NewPlainEncrypterOpts(sm2.MarshalUncompressed, sm2.C1C3C2), sm2.NewPlainDecrypterOpts(sm2.C1C3C2), nil
+	case C1C2C3:
+		return sm2.NewPlainEncrypterOpts(sm2.MarshalUncompressed, sm2.C1C2C3), sm2.NewPlainDecrypterOpts(sm2.C1C2C3), nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported ciphertext order: %v", order)
+	}
+}
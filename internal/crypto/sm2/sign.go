@@ -0,0 +1,70 @@
+package sm2
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/emmansun/gmsm/sm2"
+)
+
+// DefaultUid is the default signer ID defined by GB/T 35276.
+const DefaultUid = "1234567812345678"
+
+// ErrVerification is returned when a signature does not match.
+var ErrVerification = errors.New("sm2: verification error")
+
+// SignBase64 signs data with private key and returns base64 encoded DER signature.
+func SignBase64(data string, privateKeyBytes []byte, uid []byte) (string, error) {
+	signature, err := Sign([]byte(data), privateKeyBytes, uid)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// Sign signs data with private key and returns an ASN.1 DER signature.
+// 按 GB/T 32918.2 计算 SM3(ZA || M)，uid 为空时使用 DefaultUid。
+func Sign(data []byte, privateKeyBytes []byte, uid []byte) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKey.SignWithSM2(rand.Reader, signerUid(uid), data)
+}
+
+// VerifyFromBase64 verifies base64 encoded DER signature with public key.
+func VerifyFromBase64(data string, publicKeyBytes []byte, signatureBase64 string, uid []byte) (bool, error) {
+	signature, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode base64 signature: %w", err)
+	}
+	return Verify([]byte(data), publicKeyBytes, signature, uid)
+}
+
+// Verify verifies an ASN.1 DER signature with public key.
+// uid 必须与签名时一致，为空时使用 DefaultUid。
+func Verify(data []byte, publicKeyBytes []byte, signature []byte, uid []byte) (bool, error) {
+	// 解析公钥
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return false, err
+	}
+
+	// 验证签名
+	if !sm2.VerifyASN1WithSM2(pub, signerUid(uid), data, signature) {
+		return false, ErrVerification
+	}
+	return true, nil
+}
+
+// 未指定 uid 时使用默认值
+func signerUid(uid []byte) []byte {
+	if len(uid) == 0 {
+		return []byte(DefaultUid)
+	}
+	return uid
+}
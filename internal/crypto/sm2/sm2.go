@@ -0,0 +1,174 @@
+package sm2
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/emmansun/gmsm/sm2"
+	"github.com/emmansun/gmsm/smx509"
+
+	"go-secure-utils/internal/crypto/pemutil"
+)
+
+// PEM 块类型
+const (
+	pemTypePrivateKey = "PRIVATE KEY"
+	pemTypePublicKey  = "PUBLIC KEY"
+)
+
+// 原始密钥长度：私钥为 32 字节标量，公钥为 65 字节未压缩点
+const (
+	rawPrivateKeySize = 32
+	rawPublicKeySize  = 65
+)
+
+// Sm2KeyPair represents a pair of SM2 keys.
+type Sm2KeyPair struct {
+	PublicKey  []byte
+	PrivateKey []byte
+}
+
+// GetPublicKeyBase64 returns the base64 encoded public key.
+func (kp *Sm2KeyPair) GetPublicKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PublicKey)
+}
+
+// GetPrivateKeyBase64 returns the base64 encoded private key.
+func (kp *Sm2KeyPair) GetPrivateKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PrivateKey)
+}
+
+// GenKeyPair generates a new SM2 key pair.
+// 私钥为 PKCS8 格式，公钥为 PKIX 格式，与 OpenSSL 3 兼容。
+func GenKeyPair() (*Sm2KeyPair, error) {
+	// 生成 SM2 密钥对
+	privateKey, err := sm2.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// 将私钥转换为 PKCS8 格式
+	privateKeyBytes, err := smx509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// 将公钥转换为 PKIX 格式
+	publicKeyBytes, err := smx509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sm2KeyPair{
+		PublicKey:  publicKeyBytes,
+		PrivateKey: privateKeyBytes,
+	}, nil
+}
+
+// ExtractPublicKey extracts the PKIX public key from a private key.
+func ExtractPublicKey(privateKeyBytes []byte) ([]byte, error) {
+	// 解析私钥
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	// 提取公钥并转换为 PKIX 格式
+	return smx509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+}
+
+// PrivateKeyToPem encodes a private key as PKCS#8 "PRIVATE KEY" PEM.
+func PrivateKeyToPem(privateKeyBytes []byte) (string, error) {
+	privateKey, err := parsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	der, err := smx509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der})), nil
+}
+
+// PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func PublicKeyToPem(publicKeyBytes []byte) (string, error) {
+	pub, err := parsePublicKey(publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	der, err := smx509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: der})), nil
+}
+
+// 解析私钥，支持PKCS8、SEC1和32字节原始私钥，以及对应的PEM格式
+func parsePrivateKey(privateKeyBytes []byte) (*sm2.PrivateKey, error) {
+	// 32 字节原始私钥（常见于国内前端库导出的十六进制私钥）
+	if len(privateKeyBytes) == rawPrivateKeySize {
+		return sm2.NewPrivateKey(privateKeyBytes)
+	}
+
+	// PEM 格式先解码为 DER
+	privateKeyBytes, err := pemutil.Decode(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先尝试解析 PKCS8 格式的私钥
+	key, err := smx509.ParsePKCS8PrivateKey(privateKeyBytes)
+	if err != nil {
+		// PKCS8 解析失败，尝试解析 SEC1 格式
+		privateKey, err2 := smx509.ParseSM2PrivateKey(privateKeyBytes)
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to parse private key as PKCS8 or SEC1: %w, %w", err, err2)
+		}
+		return privateKey, nil
+	}
+
+	privateKey, ok := key.(*sm2.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an SM2 private key")
+	}
+	return privateKey, nil
+}
+
+// 解析公钥，支持PKIX、X.509证书、65字节未压缩点，以及对应的PEM格式
+func parsePublicKey(publicKeyBytes []byte) (*ecdsa.PublicKey, error) {
+	// 65 字节未压缩点
+	if len(publicKeyBytes) == rawPublicKeySize && publicKeyBytes[0] == 4 {
+		return sm2.NewPublicKey(publicKeyBytes)
+	}
+
+	// PEM 格式先解码为 DER
+	publicKeyBytes, err := pemutil.Decode(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先尝试解析 PKIX 格式的公钥
+	pubInterface, err := smx509.ParsePKIXPublicKey(publicKeyBytes)
+	if err != nil {
+		// 再尝试解析 X.509 证书
+		cert, err2 := smx509.ParseCertificate(publicKeyBytes)
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to parse public key as PKIX or certificate: %w", err)
+		}
+		pubInterface = cert.PublicKey
+	}
+
+	pub, ok := pubInterface.(*ecdsa.PublicKey)
+	if !ok || !sm2.IsSM2PublicKey(pub) {
+		return nil, errors.New("not an SM2 public key")
+	}
+	return pub, nil
+}
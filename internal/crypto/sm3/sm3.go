@@ -0,0 +1,39 @@
+package sm3
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+
+	"github.com/emmansun/gmsm/sm3"
+)
+
+// Size is the size of an SM3 digest in bytes.
+const Size = sm3.Size
+
+// Sum returns the SM3 digest of data (GB/T 32905-2016).
+func Sum(data []byte) []byte {
+	digest := sm3.Sum(data)
+	return digest[:]
+}
+
+// SumHex returns the hex encoded SM3 digest of data.
+func SumHex(data []byte) string {
+	return hex.EncodeToString(Sum(data))
+}
+
+// Hmac returns the HMAC-SM3 of data with key.
+func Hmac(data []byte, key []byte) []byte {
+	mac := hmac.New(sm3.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// HmacHex returns the hex encoded HMAC-SM3 of data with key.
+func HmacHex(data []byte, key []byte) string {
+	return hex.EncodeToString(Hmac(data, key))
+}
+
+// VerifyHmac reports whether mac is the HMAC-SM3 of data with key, using constant-time comparison.
+func VerifyHmac(data []byte, key []byte, mac []byte) bool {
+	return hmac.Equal(Hmac(data, key), mac)
+}
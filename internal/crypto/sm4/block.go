@@ -0,0 +1,150 @@
+package sm4
+
+import (
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	smcipher "github.com/emmansun/gmsm/cipher"
+
+	"go-secure-utils/internal/crypto/padding"
+)
+
+// EncryptEcbBase64 encrypts data with SM4-ECB and returns the base64 encoded ciphertext.
+func EncryptEcbBase64(data []byte, key []byte, p Padding) (string, error) {
+	encrypted, err := EncryptEcb(data, key, p)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// EncryptEcbHex encrypts data with SM4-ECB and returns the hex encoded ciphertext.
+func EncryptEcbHex(data []byte, key []byte, p Padding) (string, error) {
+	encrypted, err := EncryptEcb(data, key, p)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(encrypted), nil
+}
+
+// EncryptEcb encrypts data with SM4-ECB.
+// ECB 模式相同明文分组产生相同密文，仅用于与旧系统互通。
+func EncryptEcb(data []byte, key []byte, p Padding) ([]byte, error) {
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return encryptBlocks(smcipher.NewECBEncrypter(block), data, p)
+}
+
+// DecryptEcbFromBase64 decrypts base64 encoded SM4-ECB ciphertext.
+func DecryptEcbFromBase64(encrypted string, key []byte, p Padding) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return DecryptEcb(encryptedData, key, p)
+}
+
+// DecryptEcbFromHex decrypts hex encoded SM4-ECB ciphertext.
+func DecryptEcbFromHex(encrypted string, key []byte, p Padding) ([]byte, error) {
+	encryptedData, err := hex.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex: %w", err)
+	}
+	return DecryptEcb(encryptedData, key, p)
+}
+
+// DecryptEcb decrypts SM4-ECB ciphertext.
+func DecryptEcb(encryptedData []byte, key []byte, p Padding) ([]byte, error) {
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return decryptBlocks(smcipher.NewECBDecrypter(block), encryptedData, p)
+}
+
+// EncryptCbcBase64 encrypts data with SM4-CBC and returns the base64 encoded ciphertext.
+func EncryptCbcBase64(data []byte, key []byte, iv []byte, p Padding) (string, error) {
+	encrypted, err := EncryptCbc(data, key, iv, p)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// EncryptCbcHex encrypts data with SM4-CBC and returns the hex encoded ciphertext.
+func EncryptCbcHex(data []byte, key []byte, iv []byte, p Padding) (string, error) {
+	encrypted, err := EncryptCbc(data, key, iv, p)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(encrypted), nil
+}
+
+// EncryptCbc encrypts data with SM4-CBC using an explicit 16-byte IV.
+// 输出不包含 IV。
+func EncryptCbc(data []byte, key []byte, iv []byte, p Padding) ([]byte, error) {
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != IvSize {
+		return nil, fmt.Errorf("invalid IV length: %d", len(iv))
+	}
+	return encryptBlocks(cipher.NewCBCEncrypter(block, iv), data, p)
+}
+
+// DecryptCbcFromBase64 decrypts base64 encoded SM4-CBC ciphertext.
+func DecryptCbcFromBase64(encrypted string, key []byte, iv []byte, p Padding) ([]byte, error) {
+	encryptedData, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64: %w", err)
+	}
+	return DecryptCbc(encryptedData, key, iv, p)
+}
+
+// DecryptCbcFromHex decrypts hex encoded SM4-CBC ciphertext.
+func DecryptCbcFromHex(encrypted string, key []byte, iv []byte, p Padding) ([]byte, error) {
+	encryptedData, err := hex.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex: %w", err)
+	}
+	return DecryptCbc(encryptedData, key, iv, p)
+}
+
+// DecryptCbc decrypts SM4-CBC ciphertext with an explicit 16-byte IV.
+func DecryptCbc(encryptedData []byte, key []byte, iv []byte, p Padding) ([]byte, error) {
+	block, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != IvSize {
+		return nil, fmt.Errorf("invalid IV length: %d", len(iv))
+	}
+	return decryptBlocks(cipher.NewCBCDecrypter(block, iv), encryptedData, p)
+}
+
+// 填充后原地加密
+func encryptBlocks(mode cipher.BlockMode, data []byte, p Padding) ([]byte, error) {
+	padded, err := padding.Pad(data, BlockSize, p)
+	if err != nil {
+		return nil, err
+	}
+	mode.CryptBlocks(padded, padded)
+	return padded, nil
+}
+
+// 解密后去除填充
+func decryptBlocks(mode cipher.BlockMode, encryptedData []byte, p Padding) ([]byte, error) {
+	if len(encryptedData)%BlockSize != 0 {
+		return nil, errors.New("ciphertext is not a multiple of the block size")
+	}
+
+	plaintext := make([]byte, len(encryptedData))
+	mode.CryptBlocks(plaintext, encryptedData)
+	return padding.Unpad(plaintext, BlockSize, p)
+}
@@ -0,0 +1,64 @@
+package sm4
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"github.com/emmansun/gmsm/sm4"
+
+	"go-secure-utils/internal/crypto/padding"
+)
+
+// SM4 参数：128 位密钥和分组
+const (
+	KeySize   = sm4.BlockSize
+	BlockSize = sm4.BlockSize
+	IvSize    = sm4.BlockSize
+)
+
+// Padding selects the block padding scheme used by ECB and CBC modes.
+type Padding = padding.Padding
+
+const (
+	// PaddingPkcs7 pads with N bytes of value N (PKCS#7, same as Java "PKCS5Padding").
+	PaddingPkcs7 = padding.Pkcs7
+	// PaddingZero pads with zero bytes up to the block size; trailing zeros are stripped on decryption.
+	PaddingZero = padding.Zero
+	// PaddingNone requires the data length to be a multiple of the block size.
+	PaddingNone = padding.None
+)
+
+// ParsePadding parses a padding name such as "PKCS7", "PKCS5Padding", "zero" or "NoPadding".
+func ParsePadding(name string) (Padding, error) {
+	return padding.Parse(name)
+}
+
+// GenKey generates a random 16-byte SM4 key.
+func GenKey() ([]byte, error) {
+	return randomBytes(KeySize)
+}
+
+// GenIv generates a random 16-byte IV for CBC mode.
+func GenIv() ([]byte, error) {
+	return randomBytes(IvSize)
+}
+
+// 创建 SM4 分组密码，密钥长度必须为 16 字节
+func newCipher(key []byte) (cipher.Block, error) {
+	block, err := sm4.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SM4 key: %w", err)
+	}
+	return block, nil
+}
+
+// 生成指定长度的随机数
+func randomBytes(size int) ([]byte, error) {
+	b := make([]byte, size)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,124 @@
+package jwk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// 以 JSON 字符串为输入输出的转换函数，供 cgo 和 WASM 使用
+
+// FromPublicKey converts a public key (DER or PEM) to a JSON encoded JWK.
+func FromPublicKey(publicKeyBytes []byte, kid string) (string, error) {
+	key, err := NewPublicKey(publicKeyBytes, kid)
+	if err != nil {
+		return "", err
+	}
+	return marshal(key)
+}
+
+// FromPrivateKey converts a private key (DER or PEM) to a JSON encoded JWK.
+func FromPrivateKey(privateKeyBytes []byte, kid string) (string, error) {
+	key, err := NewPrivateKey(privateKeyBytes, kid)
+	if err != nil {
+		return "", err
+	}
+	return marshal(key)
+}
+
+// ToPublicKey converts a JSON encoded JWK (public or private) to a PKIX DER public key.
+func ToPublicKey(jwk string) ([]byte, error) {
+	key, err := ParseKey([]byte(jwk))
+	if err != nil {
+		return nil, err
+	}
+	return key.PublicKey()
+}
+
+// ToPrivateKey converts a JSON encoded private JWK to a PKCS#8 DER private key.
+func ToPrivateKey(jwk string) ([]byte, error) {
+	key, err := ParseKey([]byte(jwk))
+	if err != nil {
+		return nil, err
+	}
+	return key.PrivateKey()
+}
+
+// Thumbprint returns the base64url encoded RFC 7638 SHA-256 thumbprint of a JSON encoded JWK.
+func Thumbprint(jwk string) (string, error) {
+	key, err := ParseKey([]byte(jwk))
+	if err != nil {
+		return "", err
+	}
+	return key.Thumbprint()
+}
+
+// AddToSet adds a JSON encoded JWK to a JSON encoded JWKS. jwks 为空时新建密钥集。
+// 已有密钥、新密钥及密钥集的其他成员（如 x5c 或自定义字段）均原样保留。
+func AddToSet(jwks string, jwk string) (string, error) {
+	set := map[string]json.RawMessage{}
+	var keys []json.RawMessage
+	if jwks != "" {
+		if err := json.Unmarshal([]byte(jwks), &set); err != nil {
+			return "", fmt.Errorf("failed to parse JWKS: %w", err)
+		}
+		if data, ok := set["keys"]; ok {
+			if err := json.Unmarshal(data, &keys); err != nil {
+				return "", fmt.Errorf("failed to parse JWKS: %w", err)
+			}
+		}
+	}
+
+	key, err := ParseKey([]byte(jwk))
+	if err != nil {
+		return "", err
+	}
+
+	// 检查 kid 是否重复
+	if key.Kid != "" {
+		for _, existing := range keys {
+			var header struct {
+				Kid string `json:"kid"`
+			}
+			if json.Unmarshal(existing, &header) == nil && header.Kid == key.Kid {
+				return "", fmt.Errorf("duplicate kid: %q", key.Kid)
+			}
+		}
+	}
+
+	// 使用原始 JSON，避免丢弃 Key 结构中没有的成员
+	var keyData bytes.Buffer
+	if err := json.Compact(&keyData, []byte(jwk)); err != nil {
+		return "", err
+	}
+	keys = append(keys, keyData.Bytes())
+
+	keysData, err := json.Marshal(keys)
+	if err != nil {
+		return "", err
+	}
+	set["keys"] = keysData
+	return marshal(set)
+}
+
+// LookupKey returns the JSON encoded JWK with the given kid from a JSON encoded JWKS.
+func LookupKey(jwks string, kid string) (string, error) {
+	set, err := ParseKeySet([]byte(jwks))
+	if err != nil {
+		return "", err
+	}
+
+	key, err := set.Key(kid)
+	if err != nil {
+		return "", err
+	}
+	return marshal(key)
+}
+
+func marshal(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,248 @@
+package jwk
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// KeyTypeRsa is the "kty" value of RSA keys.
+const KeyTypeRsa = "RSA"
+
+// Key is a JSON Web Key (RFC 7517). 数值成员均为 base64url 编码（无填充）的大端整数。
+type Key struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+
+	// RSA 公钥成员
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// RSA 私钥成员
+	D  string `json:"d,omitempty"`
+	P  string `json:"p,omitempty"`
+	Q  string `json:"q,omitempty"`
+	DP string `json:"dp,omitempty"`
+	DQ string `json:"dq,omitempty"`
+	QI string `json:"qi,omitempty"`
+}
+
+// NewPublicKey converts a PKIX, PKCS#1 or certificate public key (DER or PEM) to a JWK.
+// kid 为空时使用 RFC 7638 指纹作为 kid。
+func NewPublicKey(publicKeyBytes []byte, kid string) (*Key, error) {
+	pub, err := internalrsa.ParsePublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &Key{
+		Kty: KeyTypeRsa,
+		N:   encodeInt(pub.N),
+		E:   encodeInt(big.NewInt(int64(pub.E))),
+	}
+	if err := key.setKid(kid); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// NewPrivateKey converts a PKCS#1 or PKCS#8 private key (DER or PEM) to a JWK.
+// kid 为空时使用 RFC 7638 指纹作为 kid。
+func NewPrivateKey(privateKeyBytes []byte, kid string) (*Key, error) {
+	privateKey, err := internalrsa.ParsePrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(privateKey.Primes) != 2 {
+		return nil, errors.New("multi-prime RSA keys are not supported")
+	}
+
+	privateKey.Precompute()
+	key := &Key{
+		Kty: KeyTypeRsa,
+		N:   encodeInt(privateKey.N),
+		E:   encodeInt(big.NewInt(int64(privateKey.E))),
+		D:   encodeInt(privateKey.D),
+		P:   encodeInt(privateKey.Primes[0]),
+		Q:   encodeInt(privateKey.Primes[1]),
+		DP:  encodeInt(privateKey.Precomputed.Dp),
+		DQ:  encodeInt(privateKey.Precomputed.Dq),
+		QI:  encodeInt(privateKey.Precomputed.Qinv),
+	}
+	if err := key.setKid(kid); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ParseKey parses a JSON encoded JWK.
+func ParseKey(data []byte) (*Key, error) {
+	var key Key
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("failed to parse JWK: %w", err)
+	}
+	if key.Kty != KeyTypeRsa {
+		return nil, fmt.Errorf("unsupported key type: %q", key.Kty)
+	}
+	if key.N == "" || key.E == "" {
+		return nil, errors.New("JWK is missing n or e")
+	}
+	return &key, nil
+}
+
+// IsPrivate reports whether the JWK contains private key members.
+func (k *Key) IsPrivate() bool {
+	return k.D != ""
+}
+
+// Public returns a copy of the JWK without private key members.
+func (k *Key) Public() *Key {
+	return &Key{
+		Kty: k.Kty,
+		Kid: k.Kid,
+		Use: k.Use,
+		Alg: k.Alg,
+		N:   k.N,
+		E:   k.E,
+	}
+}
+
+// PublicKey returns the public key as PKIX DER.
+func (k *Key) PublicKey() ([]byte, error) {
+	pub, err := k.rsaPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return x509.MarshalPKIXPublicKey(pub)
+}
+
+// PrivateKey returns the private key as PKCS#8 DER.
+func (k *Key) PrivateKey() ([]byte, error) {
+	privateKey, err := k.rsaPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return x509.MarshalPKCS8PrivateKey(privateKey)
+}
+
+// Thumbprint returns the base64url encoded RFC 7638 SHA-256 thumbprint.
+func (k *Key) Thumbprint() (string, error) {
+	if k.Kty != KeyTypeRsa {
+		return "", fmt.Errorf("unsupported key type: %q", k.Kty)
+	}
+	if k.N == "" || k.E == "" {
+		return "", errors.New("JWK is missing n or e")
+	}
+
+	// 必需成员按字典序排列，不含空白
+	members, err := json.Marshal(struct {
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{E: k.E, Kty: k.Kty, N: k.N})
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(members)
+	return base64.RawURLEncoding.EncodeToString(digest[:]), nil
+}
+
+// 设置 kid，为空时使用指纹
+func (k *Key) setKid(kid string) error {
+	if kid == "" {
+		thumbprint, err := k.Thumbprint()
+		if err != nil {
+			return err
+		}
+		kid = thumbprint
+	}
+	k.Kid = kid
+	return nil
+}
+
+// 转换为 RSA 公钥
+func (k *Key) rsaPublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != KeyTypeRsa {
+		return nil, fmt.Errorf("unsupported key type: %q", k.Kty)
+	}
+
+	n, err := decodeInt("n", k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeInt("e", k.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid JWK public exponent")
+	}
+
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+// 转换为 RSA 私钥，要求包含 p 和 q
+func (k *Key) rsaPrivateKey() (*rsa.PrivateKey, error) {
+	pub, err := k.rsaPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	if !k.IsPrivate() {
+		return nil, errors.New("JWK is not a private key")
+	}
+	if k.P == "" || k.Q == "" {
+		return nil, errors.New("private JWK without p and q is not supported")
+	}
+
+	d, err := decodeInt("d", k.D)
+	if err != nil {
+		return nil, err
+	}
+	p, err := decodeInt("p", k.P)
+	if err != nil {
+		return nil, err
+	}
+	q, err := decodeInt("q", k.Q)
+	if err != nil {
+		return nil, err
+	}
+
+	// CRT 参数按 p、q 重新计算
+	privateKey := &rsa.PrivateKey{
+		PublicKey: *pub,
+		D:         d,
+		Primes:    []*big.Int{p, q},
+	}
+	if err := privateKey.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid JWK private key: %w", err)
+	}
+	privateKey.Precompute()
+	return privateKey, nil
+}
+
+// base64url 编码大端整数
+func encodeInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+// base64url 解码大端整数
+func decodeInt(name, value string) (*big.Int, error) {
+	if value == "" {
+		return nil, fmt.Errorf("JWK is missing %s", name)
+	}
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JWK member %s: %w", name, err)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,61 @@
+package jwk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// KeySet is a JSON Web Key Set.
+type KeySet struct {
+	Keys []*Key `json:"keys"`
+}
+
+// ParseKeySet parses a JSON encoded JWKS. 不支持的密钥类型会被跳过。
+func ParseKeySet(data []byte) (*KeySet, error) {
+	var raw struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	set := &KeySet{Keys: []*Key{}}
+	for _, keyData := range raw.Keys {
+		key, err := ParseKey(keyData)
+		if err != nil {
+			continue
+		}
+		set.Keys = append(set.Keys, key)
+	}
+	return set, nil
+}
+
+// Key returns the key with the given kid.
+func (s *KeySet) Key(kid string) (*Key, error) {
+	for _, key := range s.Keys {
+		if key.Kid == kid {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("key not found: %q", kid)
+}
+
+// Add appends a key to the set. 已存在相同 kid 时返回错误。
+func (s *KeySet) Add(key *Key) error {
+	if key.Kid != "" {
+		if _, err := s.Key(key.Kid); err == nil {
+			return fmt.Errorf("duplicate kid: %q", key.Kid)
+		}
+	}
+	s.Keys = append(s.Keys, key)
+	return nil
+}
+
+// Public returns a copy of the set without private key members.
+func (s *KeySet) Public() *KeySet {
+	set := &KeySet{Keys: make([]*Key, 0, len(s.Keys))}
+	for _, key := range s.Keys {
+		set.Keys = append(set.Keys, key.Public())
+	}
+	return set
+}
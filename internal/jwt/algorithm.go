@@ -0,0 +1,137 @@
+package jwt
+
+import (
+	"errors"
+	"fmt"
+
+	internalecdsa "go-secure-utils/internal/crypto/ecdsa"
+	internaled25519 "go-secure-utils/internal/crypto/ed25519"
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// Algorithm is a JWS "alg" header value (RFC 7518).
+type Algorithm string
+
+// Supported signature algorithms. "none" 和 HMAC 算法均不支持。
+const (
+	RS256 Algorithm = "RS256"
+	RS384 Algorithm = "RS384"
+	RS512 Algorithm = "RS512"
+	PS256 Algorithm = "PS256"
+	PS384 Algorithm = "PS384"
+	PS512 Algorithm = "PS512"
+	ES256 Algorithm = "ES256"
+	ES384 Algorithm = "ES384"
+	ES512 Algorithm = "ES512"
+	EdDSA Algorithm = "EdDSA"
+)
+
+// 按算法签名 JWS 签名输入
+func (alg Algorithm) sign(signingInput []byte, privateKeyBytes []byte) ([]byte, error) {
+	switch alg {
+	case RS256, RS384, RS512, PS256, PS384, PS512:
+		return internalrsa.SignWithOptions(signingInput, privateKeyBytes, alg.rsaSignOptions())
+	case ES256, ES384, ES512:
+		// 曲线决定摘要算法，必须与 alg 一致
+		publicKeyBytes, err := internalecdsa.ExtractPublicKey(privateKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+		if err := alg.checkEcdsaCurve(publicKeyBytes); err != nil {
+			return nil, err
+		}
+		return internalecdsa.SignRaw(signingInput, privateKeyBytes)
+	case EdDSA:
+		return internaled25519.Sign(signingInput, privateKeyBytes)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %s", alg)
+	}
+}
+
+// 按算法验证 JWS 签名
+func (alg Algorithm) verify(signingInput []byte, publicKeyBytes []byte, signature []byte) error {
+	var err error
+	switch alg {
+	case RS256, RS384, RS512, PS256, PS384, PS512:
+		_, err = internalrsa.VerifyWithOptions(signingInput, publicKeyBytes, signature, alg.rsaSignOptions())
+	case ES256, ES384, ES512:
+		if err = alg.checkEcdsaCurve(publicKeyBytes); err == nil {
+			_, err = internalecdsa.VerifyRaw(signingInput, publicKeyBytes, signature)
+		}
+	case EdDSA:
+		_, err = internaled25519.Verify(signingInput, publicKeyBytes, signature)
+	default:
+		return fmt.Errorf("unsupported algorithm: %s", alg)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrVerification, err)
+	}
+	return nil
+}
+
+// RS* 使用 PKCS#1 v1.5 填充，PS* 使用 PSS 填充且盐长度等于摘要长度
+func (alg Algorithm) rsaSignOptions() *internalrsa.SignOptions {
+	opts := &internalrsa.SignOptions{}
+	switch alg {
+	case RS256, PS256:
+		opts.Hash = internalrsa.SHA256
+	case RS384, PS384:
+		opts.Hash = internalrsa.SHA384
+	case RS512, PS512:
+		opts.Hash = internalrsa.SHA512
+	}
+	if alg == PS256 || alg == PS384 || alg == PS512 {
+		opts.Padding = internalrsa.PaddingPss
+	}
+	return opts
+}
+
+// ES* 的 alg 与曲线一一对应，密钥曲线必须与 alg 一致
+func (alg Algorithm) checkEcdsaCurve(publicKeyBytes []byte) error {
+	curve, err := internalecdsa.PublicKeyCurve(publicKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	expected := internalecdsa.P256
+	switch alg {
+	case ES384:
+		expected = internalecdsa.P384
+	case ES512:
+		expected = internalecdsa.P521
+	}
+	if curve != expected {
+		return fmt.Errorf("key curve %s does not match algorithm %s", curve, alg)
+	}
+	return nil
+}
+
+// 根据私钥类型推断默认算法：RSA 使用 RS256，EC 按曲线使用 ES256/ES384/ES512，Ed25519 使用 EdDSA
+func defaultAlgorithm(privateKeyBytes []byte) (Algorithm, error) {
+	if _, err := internalrsa.ExtractPublicKey(privateKeyBytes); err == nil {
+		return RS256, nil
+	}
+
+	if publicKey, err := internalecdsa.ExtractPublicKey(privateKeyBytes); err == nil {
+		curve, err := internalecdsa.PublicKeyCurve(publicKey)
+		if err != nil {
+			return "", err
+		}
+		switch curve {
+		case internalecdsa.P256:
+			return ES256, nil
+		case internalecdsa.P384:
+			return ES384, nil
+		case internalecdsa.P521:
+			return ES512, nil
+		}
+	}
+
+	// Ed25519 也接受32字节种子，因此最后尝试
+	if _, err := internaled25519.ExtractPublicKey(privateKeyBytes); err == nil {
+		return EdDSA, nil
+	}
+
+	return "", errors.New("unsupported private key type")
+}
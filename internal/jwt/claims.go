@@ -0,0 +1,84 @@
+package jwt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Claim validation errors.
+var (
+	ErrTokenExpired        = errors.New("jwt: token is expired")
+	ErrTokenNotValidYet    = errors.New("jwt: token is not valid yet")
+	ErrTokenIssuedInFuture = errors.New("jwt: token is issued in the future")
+	ErrInvalidIssuer       = errors.New("jwt: invalid issuer")
+	ErrInvalidAudience     = errors.New("jwt: invalid audience")
+)
+
+// registeredClaims holds the registered claims (RFC 7519 §4.1) checked by Verify.
+type registeredClaims struct {
+	Iss *string         `json:"iss"`
+	Aud json.RawMessage `json:"aud"`
+	Exp *float64        `json:"exp"`
+	Nbf *float64        `json:"nbf"`
+	Iat *float64        `json:"iat"`
+}
+
+// 校验 exp/nbf/iat/iss/aud 声明
+func validateClaims(payload []byte, now time.Time, opts *VerifyOptions) error {
+	var claims registeredClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return fmt.Errorf("failed to parse claims: %w", err)
+	}
+
+	// NumericDate 为秒，允许小数
+	skew := opts.ClockSkew.Seconds()
+	seconds := float64(now.UnixNano()) / float64(time.Second)
+
+	switch {
+	case claims.Exp == nil && opts.RequireExpiration:
+		return errors.New("jwt: missing exp claim")
+	case claims.Exp != nil && seconds >= *claims.Exp+skew:
+		return ErrTokenExpired
+	case claims.Nbf != nil && seconds+skew < *claims.Nbf:
+		return ErrTokenNotValidYet
+	case claims.Iat != nil && seconds+skew < *claims.Iat:
+		return ErrTokenIssuedInFuture
+	}
+
+	if opts.Issuer != "" && (claims.Iss == nil || *claims.Iss != opts.Issuer) {
+		return ErrInvalidIssuer
+	}
+
+	if opts.Audience != "" {
+		audience, err := parseAudience(claims.Aud)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(audience, opts.Audience) {
+			return ErrInvalidAudience
+		}
+	}
+
+	return nil
+}
+
+// aud 可以是字符串或字符串数组
+func parseAudience(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}, nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(raw, &multiple); err != nil {
+		return nil, fmt.Errorf("invalid aud claim: %w", err)
+	}
+	return multiple, nil
+}
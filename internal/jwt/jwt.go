@@ -0,0 +1,198 @@
+package jwt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrVerification is returned when a token signature does not match.
+var ErrVerification = errors.New("jwt: verification error")
+
+// JWS 紧凑序列化使用不带填充的 base64url
+var segmentEncoding = base64.RawURLEncoding
+
+// header is the JOSE header of a token.
+type header struct {
+	Alg  Algorithm `json:"alg"`
+	Kid  string    `json:"kid,omitempty"`
+	Typ  string    `json:"typ,omitempty"`
+	Crit []string  `json:"crit,omitempty"`
+}
+
+// SignOptions configures Sign. 为nil时按私钥类型选择算法。
+type SignOptions struct {
+	// Algorithm is the "alg" header. Empty means RS256 for RSA keys,
+	// ES256/ES384/ES512 for EC keys by curve and EdDSA for Ed25519 keys.
+	Algorithm Algorithm
+	// Kid is the optional "kid" header, used to select the verification key from a JWKS.
+	Kid string
+	// Type is the "typ" header. Empty means "JWT".
+	Type string
+}
+
+// VerifyOptions configures Verify. 为nil时只验证签名和 exp/nbf/iat。
+type VerifyOptions struct {
+	// Algorithms restricts the accepted "alg" header values. Empty accepts all supported algorithms.
+	Algorithms []Algorithm
+	// Issuer, if set, must equal the "iss" claim.
+	Issuer string
+	// Audience, if set, must be the "aud" claim or one of its members.
+	Audience string
+	// ClockSkew is the leeway applied to exp, nbf and iat.
+	ClockSkew time.Duration
+	// RequireExpiration rejects tokens without an "exp" claim.
+	RequireExpiration bool
+	// Now returns the current time. Nil means time.Now.
+	Now func() time.Time
+}
+
+// Sign builds a JWS compact serialization of the JSON encoded claims signed with private key.
+// claims 必须为 JSON 对象，原样（压缩空白后）作为载荷。
+func Sign(claims string, privateKeyBytes []byte, opts *SignOptions) (string, error) {
+	var o SignOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	// 载荷必须是 JSON 对象
+	payload, err := compactClaims(claims)
+	if err != nil {
+		return "", err
+	}
+
+	if o.Algorithm == "" {
+		if o.Algorithm, err = defaultAlgorithm(privateKeyBytes); err != nil {
+			return "", err
+		}
+	}
+	if o.Type == "" {
+		o.Type = "JWT"
+	}
+
+	headerJson, err := json.Marshal(header{Alg: o.Algorithm, Kid: o.Kid, Typ: o.Type})
+	if err != nil {
+		return "", err
+	}
+
+	// 签名输入为 base64url(header) + "." + base64url(payload)
+	signingInput := segmentEncoding.EncodeToString(headerJson) + "." + segmentEncoding.EncodeToString(payload)
+	signature, err := o.Algorithm.sign([]byte(signingInput), privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + segmentEncoding.EncodeToString(signature), nil
+}
+
+// Verify verifies the token signature with public key, validates the registered claims
+// and returns the JSON encoded claims.
+func Verify(token string, publicKeyBytes []byte, opts *VerifyOptions) (string, error) {
+	var o VerifyOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	h, payload, signature, err := split(token)
+	if err != nil {
+		return "", err
+	}
+
+	// 校验算法和关键扩展头
+	if len(o.Algorithms) > 0 && !slices.Contains(o.Algorithms, h.Alg) {
+		return "", fmt.Errorf("algorithm not allowed: %s", h.Alg)
+	}
+	if len(h.Crit) > 0 {
+		return "", fmt.Errorf("unsupported critical header: %s", strings.Join(h.Crit, ", "))
+	}
+
+	// 验证签名
+	signingInput := token[:strings.LastIndexByte(token, '.')]
+	if err := h.Alg.verify([]byte(signingInput), publicKeyBytes, signature); err != nil {
+		return "", err
+	}
+
+	// 验证注册声明
+	now := time.Now()
+	if o.Now != nil {
+		now = o.Now()
+	}
+	if err := validateClaims(payload, now, &o); err != nil {
+		return "", err
+	}
+
+	return string(payload), nil
+}
+
+// DecodeHeader returns the JSON encoded header of a token without verifying it.
+// 可用于在验证前读取 kid 以从 JWKS 中选择公钥。
+func DecodeHeader(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("invalid token format")
+	}
+
+	headerJson, err := segmentEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", fmt.Errorf("failed to decode header: %w", err)
+	}
+	return string(headerJson), nil
+}
+
+// DecodeClaims returns the JSON encoded claims of a token without verifying it.
+// 未经验证的声明不可信，只能用于展示或调试。
+func DecodeClaims(token string) (string, error) {
+	_, payload, _, err := split(token)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+// 拆分并解码紧凑序列化的三个部分
+func split(token string) (*header, []byte, []byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, nil, nil, errors.New("invalid token format")
+	}
+
+	headerJson, err := segmentEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode header: %w", err)
+	}
+	var h header
+	if err := json.Unmarshal(headerJson, &h); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse header: %w", err)
+	}
+
+	payload, err := segmentEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	signature, err := segmentEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	return &h, payload, signature, nil
+}
+
+// 校验声明为 JSON 对象并压缩空白
+func compactClaims(claims string) ([]byte, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(claims), &object); err != nil {
+		return nil, fmt.Errorf("claims must be a JSON object: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(claims)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
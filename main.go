@@ -2,7 +2,21 @@
 package main
 
 import (
+	aespkg "go-secure-utils/pkg/crypto/aes"
+	chachapkg "go-secure-utils/pkg/crypto/chacha20poly1305"
+	ecdhpkg "go-secure-utils/pkg/crypto/ecdh"
+	ecdsapkg "go-secure-utils/pkg/crypto/ecdsa"
+	ed25519pkg "go-secure-utils/pkg/crypto/ed25519"
+	envelopepkg "go-secure-utils/pkg/crypto/envelope"
+	hashpkg "go-secure-utils/pkg/crypto/hash"
+	kdfpkg "go-secure-utils/pkg/crypto/kdf"
+	randompkg "go-secure-utils/pkg/crypto/random"
 	rsapkg "go-secure-utils/pkg/crypto/rsa"
+	sm2pkg "go-secure-utils/pkg/crypto/sm2"
+	sm3pkg "go-secure-utils/pkg/crypto/sm3"
+	sm4pkg "go-secure-utils/pkg/crypto/sm4"
+	jwkpkg "go-secure-utils/pkg/jwk"
+	jwtpkg "go-secure-utils/pkg/jwt"
 )
 
 // RsaKeyPair represents a pair of RSA keys.
@@ -23,6 +37,23 @@ func RsaGenKeyPair(keySize int) (*RsaKeyPair, error) {
 	return rsapkg.GenKeyPair(keySize)
 }
 
+// RsaPrivateKeyFormat identifies the encoding format of an RSA private key.
+type RsaPrivateKeyFormat = rsapkg.PrivateKeyFormat
+
+// RsaPublicKeyFormat identifies the encoding format of an RSA public key.
+type RsaPublicKeyFormat = rsapkg.PublicKeyFormat
+
+// RsaKeyEncoding identifies how generated key bytes are encoded.
+type RsaKeyEncoding = rsapkg.KeyEncoding
+
+// RsaKeyPairOptions configures RSA key pair generation.
+type RsaKeyPairOptions = rsapkg.KeyPairOptions
+
+// RsaGenKeyPairWithOptions generates a new RSA key pair with the given formats, encoding and public exponent.
+func RsaGenKeyPairWithOptions(opts *RsaKeyPairOptions) (*RsaKeyPair, error) {
+	return rsapkg.GenKeyPairWithOptions(opts)
+}
+
 // RsaExtractPublicKey extracts the public key from a private key.
 func RsaExtractPublicKey(privateKey []byte) ([]byte, error) {
 	return rsapkg.ExtractPublicKey(privateKey)
@@ -77,3 +108,1026 @@ func RsaVerify(data []byte, publicKey []byte, signature []byte) (bool, error) {
 func RsaVerifySha1(data []byte, publicKey []byte, signature []byte) (bool, error) {
 	return rsapkg.VerifySha1(data, publicKey, signature)
 }
+
+// RsaHash identifies a hash algorithm used by RSA padding schemes.
+type RsaHash = rsapkg.Hash
+
+// RsaOaepOptions configures RSA-OAEP encryption and decryption.
+type RsaOaepOptions = rsapkg.OaepOptions
+
+// RsaParseHash parses a hash algorithm name such as "SHA-256".
+func RsaParseHash(name string) (RsaHash, error) {
+	return rsapkg.ParseHash(name)
+}
+
+// RsaEncryptOaepBase64 encrypts data with public key using RSA-OAEP and returns base64 encoded result.
+func RsaEncryptOaepBase64(data []byte, publicKey []byte, opts *RsaOaepOptions) (string, error) {
+	return rsapkg.EncryptOaepBase64(data, publicKey, opts)
+}
+
+// RsaEncryptOaep encrypts data with public key using RSA-OAEP.
+func RsaEncryptOaep(data []byte, publicKey []byte, opts *RsaOaepOptions) ([]byte, error) {
+	return rsapkg.EncryptOaep(data, publicKey, opts)
+}
+
+// RsaDecryptOaepFromBase64 decrypts base64 encoded data with private key using RSA-OAEP.
+func RsaDecryptOaepFromBase64(encrypted string, privateKey []byte, opts *RsaOaepOptions) ([]byte, error) {
+	return rsapkg.DecryptOaepFromBase64(encrypted, privateKey, opts)
+}
+
+// RsaDecryptOaep decrypts data with private key using RSA-OAEP.
+func RsaDecryptOaep(encryptedData []byte, privateKey []byte, opts *RsaOaepOptions) ([]byte, error) {
+	return rsapkg.DecryptOaep(encryptedData, privateKey, opts)
+}
+
+// RsaParsePrivateKeyFormat parses a private key format name such as "PKCS1" or "PKCS8".
+func RsaParsePrivateKeyFormat(name string) (RsaPrivateKeyFormat, error) {
+	return rsapkg.ParsePrivateKeyFormat(name)
+}
+
+// RsaParsePublicKeyFormat parses a public key format name such as "PKIX" or "PKCS1".
+func RsaParsePublicKeyFormat(name string) (RsaPublicKeyFormat, error) {
+	return rsapkg.ParsePublicKeyFormat(name)
+}
+
+// RsaParseKeyEncoding parses a key encoding name such as "DER", "PEM" or "Base64".
+func RsaParseKeyEncoding(name string) (RsaKeyEncoding, error) {
+	return rsapkg.ParseKeyEncoding(name)
+}
+
+// RsaPssOptions configures RSASSA-PSS signing and verification.
+type RsaPssOptions = rsapkg.PssOptions
+
+// RsaSignPssBase64 signs data with private key using RSASSA-PSS and returns base64 encoded signature.
+func RsaSignPssBase64(data string, privateKey []byte, opts *RsaPssOptions) (string, error) {
+	return rsapkg.SignPssBase64(data, privateKey, opts)
+}
+
+// RsaSignPss signs data with private key using RSASSA-PSS.
+func RsaSignPss(data []byte, privateKey []byte, opts *RsaPssOptions) ([]byte, error) {
+	return rsapkg.SignPss(data, privateKey, opts)
+}
+
+// RsaVerifyPssFromBase64 verifies base64 encoded RSASSA-PSS signature with public key.
+func RsaVerifyPssFromBase64(data string, publicKey []byte, signature string, opts *RsaPssOptions) (bool, error) {
+	return rsapkg.VerifyPssFromBase64(data, publicKey, signature, opts)
+}
+
+// RsaVerifyPss verifies RSASSA-PSS signature with public key.
+func RsaVerifyPss(data []byte, publicKey []byte, signature []byte, opts *RsaPssOptions) (bool, error) {
+	return rsapkg.VerifyPss(data, publicKey, signature, opts)
+}
+
+// RsaPadding identifies an RSA signature padding scheme.
+type RsaPadding = rsapkg.Padding
+
+// RsaSignOptions configures RSA signing and verification.
+type RsaSignOptions = rsapkg.SignOptions
+
+// RsaParsePadding parses a padding scheme name such as "PKCS1v15" or "PSS".
+func RsaParsePadding(name string) (RsaPadding, error) {
+	return rsapkg.ParsePadding(name)
+}
+
+// RsaSignWithOptions signs data with private key using the given hash and padding scheme.
+func RsaSignWithOptions(data []byte, privateKey []byte, opts *RsaSignOptions) ([]byte, error) {
+	return rsapkg.SignWithOptions(data, privateKey, opts)
+}
+
+// RsaVerifyWithOptions verifies signature with public key using the given hash and padding scheme.
+func RsaVerifyWithOptions(data []byte, publicKey []byte, signature []byte, opts *RsaSignOptions) (bool, error) {
+	return rsapkg.VerifyWithOptions(data, publicKey, signature, opts)
+}
+
+// RsaSignDigest signs an already-computed digest with private key.
+func RsaSignDigest(digest []byte, privateKey []byte, opts *RsaSignOptions) ([]byte, error) {
+	return rsapkg.SignDigest(digest, privateKey, opts)
+}
+
+// RsaVerifyDigest verifies signature of an already-computed digest with public key.
+func RsaVerifyDigest(digest []byte, publicKey []byte, signature []byte, opts *RsaSignOptions) (bool, error) {
+	return rsapkg.VerifyDigest(digest, publicKey, signature, opts)
+}
+
+// RsaSigner signs data written in chunks.
+type RsaSigner = rsapkg.Signer
+
+// RsaVerifier verifies a signature over data written in chunks.
+type RsaVerifier = rsapkg.Verifier
+
+// RsaNewSigner creates a streaming signer for the given private key, hash and padding scheme.
+func RsaNewSigner(privateKey []byte, opts *RsaSignOptions) (*RsaSigner, error) {
+	return rsapkg.NewSigner(privateKey, opts)
+}
+
+// RsaNewVerifier creates a streaming verifier for the given public key, hash and padding scheme.
+func RsaNewVerifier(publicKey []byte, opts *RsaSignOptions) (*RsaVerifier, error) {
+	return rsapkg.NewVerifier(publicKey, opts)
+}
+
+// RsaEncryptSegmentedBase64 encrypts data of any length block by block with PKCS#1 v1.5 and returns base64 encoded result.
+func RsaEncryptSegmentedBase64(data []byte, publicKey []byte) (string, error) {
+	return rsapkg.EncryptSegmentedBase64(data, publicKey)
+}
+
+// RsaEncryptSegmented encrypts data of any length block by block with PKCS#1 v1.5.
+func RsaEncryptSegmented(data []byte, publicKey []byte) ([]byte, error) {
+	return rsapkg.EncryptSegmented(data, publicKey)
+}
+
+// RsaDecryptSegmentedFromBase64 decrypts base64 encoded block-wise PKCS#1 v1.5 ciphertext with private key.
+func RsaDecryptSegmentedFromBase64(encrypted string, privateKey []byte) ([]byte, error) {
+	return rsapkg.DecryptSegmentedFromBase64(encrypted, privateKey)
+}
+
+// RsaDecryptSegmented decrypts block-wise PKCS#1 v1.5 ciphertext with private key.
+func RsaDecryptSegmented(encryptedData []byte, privateKey []byte) ([]byte, error) {
+	return rsapkg.DecryptSegmented(encryptedData, privateKey)
+}
+
+// RsaEncryptOaepSegmentedBase64 encrypts data of any length block by block with RSA-OAEP and returns base64 encoded result.
+func RsaEncryptOaepSegmentedBase64(data []byte, publicKey []byte, opts *RsaOaepOptions) (string, error) {
+	return rsapkg.EncryptOaepSegmentedBase64(data, publicKey, opts)
+}
+
+// RsaEncryptOaepSegmented encrypts data of any length block by block with RSA-OAEP.
+func RsaEncryptOaepSegmented(data []byte, publicKey []byte, opts *RsaOaepOptions) ([]byte, error) {
+	return rsapkg.EncryptOaepSegmented(data, publicKey, opts)
+}
+
+// RsaDecryptOaepSegmentedFromBase64 decrypts base64 encoded block-wise RSA-OAEP ciphertext with private key.
+func RsaDecryptOaepSegmentedFromBase64(encrypted string, privateKey []byte, opts *RsaOaepOptions) ([]byte, error) {
+	return rsapkg.DecryptOaepSegmentedFromBase64(encrypted, privateKey, opts)
+}
+
+// RsaDecryptOaepSegmented decrypts block-wise RSA-OAEP ciphertext with private key.
+func RsaDecryptOaepSegmented(encryptedData []byte, privateKey []byte, opts *RsaOaepOptions) ([]byte, error) {
+	return rsapkg.DecryptOaepSegmented(encryptedData, privateKey, opts)
+}
+
+// RsaPrivateKeyToPem encodes a PKCS#1 or PKCS#8 private key as PEM.
+func RsaPrivateKeyToPem(privateKey []byte) (string, error) {
+	return rsapkg.PrivateKeyToPem(privateKey)
+}
+
+// RsaPublicKeyToPem encodes a PKIX or PKCS#1 public key as PEM.
+func RsaPublicKeyToPem(publicKey []byte) (string, error) {
+	return rsapkg.PublicKeyToPem(publicKey)
+}
+
+// RsaPemToDer decodes the first PEM block and returns its DER bytes.
+func RsaPemToDer(pemData string) ([]byte, error) {
+	return rsapkg.PemToDer(pemData)
+}
+
+// RsaConvertPkcs8ToPkcs1 converts PKCS#8 encoded private key to PKCS#1.
+func RsaConvertPkcs8ToPkcs1(pkcs8 []byte) ([]byte, error) {
+	return rsapkg.ConvertPkcs8ToPkcs1(pkcs8)
+}
+
+// RsaConvertPkcs1ToPkcs8 converts PKCS#1 encoded private key to PKCS#8.
+func RsaConvertPkcs1ToPkcs8(pkcs1 []byte) ([]byte, error) {
+	return rsapkg.ConvertPkcs1ToPkcs8(pkcs1)
+}
+
+// RsaConvertPublicKeyToPkcs1 converts a public key to PKCS#1 RSAPublicKey.
+func RsaConvertPublicKeyToPkcs1(publicKey []byte) ([]byte, error) {
+	return rsapkg.ConvertPublicKeyToPkcs1(publicKey)
+}
+
+// RsaConvertPublicKeyToPkix converts a public key to PKIX SubjectPublicKeyInfo.
+func RsaConvertPublicKeyToPkix(publicKey []byte) ([]byte, error) {
+	return rsapkg.ConvertPublicKeyToPkix(publicKey)
+}
+
+// RsaKeyDerivation identifies the password-based key derivation function.
+type RsaKeyDerivation = rsapkg.KeyDerivation
+
+// RsaEncryptPrivateKeyOptions configures password-based private key encryption.
+type RsaEncryptPrivateKeyOptions = rsapkg.EncryptPrivateKeyOptions
+
+// RsaParseKeyDerivation parses a key derivation function name such as "PBKDF2" or "scrypt".
+func RsaParseKeyDerivation(name string) (RsaKeyDerivation, error) {
+	return rsapkg.ParseKeyDerivation(name)
+}
+
+// RsaEncryptPrivateKey encrypts a private key with password as PKCS#8 EncryptedPrivateKeyInfo DER.
+func RsaEncryptPrivateKey(privateKey []byte, password []byte, opts *RsaEncryptPrivateKeyOptions) ([]byte, error) {
+	return rsapkg.EncryptPrivateKey(privateKey, password, opts)
+}
+
+// RsaEncryptPrivateKeyToPem encrypts a private key with password as "ENCRYPTED PRIVATE KEY" PEM.
+func RsaEncryptPrivateKeyToPem(privateKey []byte, password []byte, opts *RsaEncryptPrivateKeyOptions) (string, error) {
+	return rsapkg.EncryptPrivateKeyToPem(privateKey, password, opts)
+}
+
+// RsaDecryptPrivateKey decrypts a PKCS#8 EncryptedPrivateKeyInfo (DER or PEM) with password and returns PKCS#8 DER.
+func RsaDecryptPrivateKey(encryptedKey []byte, password []byte) ([]byte, error) {
+	return rsapkg.DecryptPrivateKey(encryptedKey, password)
+}
+
+// RsaPublicKeyToOpenSsh converts a public key to an OpenSSH authorized_keys line.
+func RsaPublicKeyToOpenSsh(publicKey []byte, comment string) (string, error) {
+	return rsapkg.PublicKeyToOpenSsh(publicKey, comment)
+}
+
+// RsaOpenSshToPublicKey parses an OpenSSH authorized_keys line and returns the public key as PKIX DER.
+func RsaOpenSshToPublicKey(authorizedKey string) ([]byte, error) {
+	return rsapkg.OpenSshToPublicKey(authorizedKey)
+}
+
+// RsaPrivateKeyToOpenSsh encodes a private key as "OPENSSH PRIVATE KEY" PEM, optionally passphrase-protected.
+func RsaPrivateKeyToOpenSsh(privateKey []byte, passphrase []byte, comment string) (string, error) {
+	return rsapkg.PrivateKeyToOpenSsh(privateKey, passphrase, comment)
+}
+
+// RsaOpenSshToPrivateKey parses an "OPENSSH PRIVATE KEY" PEM and returns the private key as PKCS#1 DER.
+func RsaOpenSshToPrivateKey(pemData string, passphrase []byte) ([]byte, error) {
+	return rsapkg.OpenSshToPrivateKey(pemData, passphrase)
+}
+
+// RsaOpenSshFingerprint returns the "SHA256:..." fingerprint of a public key as printed by "ssh-keygen -l".
+func RsaOpenSshFingerprint(publicKey []byte) (string, error) {
+	return rsapkg.OpenSshFingerprint(publicKey)
+}
+
+// EnvelopeSealBase64 encrypts data of any length for the RSA public key and returns the base64 encoded envelope.
+func EnvelopeSealBase64(data []byte, publicKey []byte) (string, error) {
+	return envelopepkg.SealBase64(data, publicKey)
+}
+
+// EnvelopeSeal encrypts data of any length for the RSA public key.
+func EnvelopeSeal(data []byte, publicKey []byte) ([]byte, error) {
+	return envelopepkg.Seal(data, publicKey)
+}
+
+// EnvelopeOpenFromBase64 decrypts a base64 encoded envelope with RSA private key.
+func EnvelopeOpenFromBase64(sealed string, privateKey []byte) ([]byte, error) {
+	return envelopepkg.OpenFromBase64(sealed, privateKey)
+}
+
+// EnvelopeOpen decrypts an envelope with RSA private key.
+func EnvelopeOpen(sealed []byte, privateKey []byte) ([]byte, error) {
+	return envelopepkg.Open(sealed, privateKey)
+}
+
+// EcdsaKeyPair represents a pair of ECDSA keys.
+type EcdsaKeyPair = ecdsapkg.EcdsaKeyPair
+
+// EcdsaCurve identifies a NIST elliptic curve.
+type EcdsaCurve = ecdsapkg.Curve
+
+// EcdsaGetPublicKeyBase64 returns the base64 encoded public key.
+func EcdsaGetPublicKeyBase64(kp *EcdsaKeyPair) string {
+	return kp.GetPublicKeyBase64()
+}
+
+// EcdsaGetPrivateKeyBase64 returns the base64 encoded private key.
+func EcdsaGetPrivateKeyBase64(kp *EcdsaKeyPair) string {
+	return kp.GetPrivateKeyBase64()
+}
+
+// EcdsaParseCurve parses a curve name such as "P-256", "secp256r1" or "prime256v1".
+func EcdsaParseCurve(name string) (EcdsaCurve, error) {
+	return ecdsapkg.ParseCurve(name)
+}
+
+// EcdsaGenKeyPair generates a new ECDSA key pair on the given curve.
+func EcdsaGenKeyPair(curve EcdsaCurve) (*EcdsaKeyPair, error) {
+	return ecdsapkg.GenKeyPair(curve)
+}
+
+// EcdsaExtractPublicKey extracts the PKIX public key from a private key.
+func EcdsaExtractPublicKey(privateKey []byte) ([]byte, error) {
+	return ecdsapkg.ExtractPublicKey(privateKey)
+}
+
+// EcdsaSignBase64 signs data with private key and returns base64 encoded DER signature.
+func EcdsaSignBase64(data string, privateKey []byte) (string, error) {
+	return ecdsapkg.SignBase64(data, privateKey)
+}
+
+// EcdsaSign signs data with private key and returns an ASN.1 DER signature.
+func EcdsaSign(data []byte, privateKey []byte) ([]byte, error) {
+	return ecdsapkg.Sign(data, privateKey)
+}
+
+// EcdsaSignRaw signs data with private key and returns a fixed-length r||s signature.
+func EcdsaSignRaw(data []byte, privateKey []byte) ([]byte, error) {
+	return ecdsapkg.SignRaw(data, privateKey)
+}
+
+// EcdsaVerifyFromBase64 verifies base64 encoded DER signature with public key.
+func EcdsaVerifyFromBase64(data string, publicKey []byte, signatureBase64 string) (bool, error) {
+	return ecdsapkg.VerifyFromBase64(data, publicKey, signatureBase64)
+}
+
+// EcdsaVerify verifies an ASN.1 DER signature with public key.
+func EcdsaVerify(data []byte, publicKey []byte, signature []byte) (bool, error) {
+	return ecdsapkg.Verify(data, publicKey, signature)
+}
+
+// EcdsaVerifyRaw verifies a fixed-length r||s signature with public key.
+func EcdsaVerifyRaw(data []byte, publicKey []byte, signature []byte) (bool, error) {
+	return ecdsapkg.VerifyRaw(data, publicKey, signature)
+}
+
+// EcdsaDerToRawSignature converts an ASN.1 DER signature to fixed-length r||s for the given curve.
+func EcdsaDerToRawSignature(signature []byte, curve EcdsaCurve) ([]byte, error) {
+	return ecdsapkg.DerToRawSignature(signature, curve)
+}
+
+// EcdsaRawToDerSignature converts a fixed-length r||s signature to ASN.1 DER.
+func EcdsaRawToDerSignature(signature []byte) ([]byte, error) {
+	return ecdsapkg.RawToDerSignature(signature)
+}
+
+// EcdsaConvertPkcs8ToSec1 converts a PKCS#8 encoded private key to SEC 1 ECPrivateKey.
+func EcdsaConvertPkcs8ToSec1(pkcs8 []byte) ([]byte, error) {
+	return ecdsapkg.ConvertPkcs8ToSec1(pkcs8)
+}
+
+// EcdsaConvertSec1ToPkcs8 converts a SEC 1 ECPrivateKey to PKCS#8.
+func EcdsaConvertSec1ToPkcs8(sec1 []byte) ([]byte, error) {
+	return ecdsapkg.ConvertSec1ToPkcs8(sec1)
+}
+
+// EcdsaPrivateKeyToPem encodes a PKCS#8 or SEC 1 private key as PEM.
+func EcdsaPrivateKeyToPem(privateKey []byte) (string, error) {
+	return ecdsapkg.PrivateKeyToPem(privateKey)
+}
+
+// EcdsaPublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func EcdsaPublicKeyToPem(publicKey []byte) (string, error) {
+	return ecdsapkg.PublicKeyToPem(publicKey)
+}
+
+// Ed25519KeyPair represents a pair of Ed25519 keys.
+type Ed25519KeyPair = ed25519pkg.Ed25519KeyPair
+
+// Ed25519GetPublicKeyBase64 returns the base64 encoded public key.
+func Ed25519GetPublicKeyBase64(kp *Ed25519KeyPair) string {
+	return kp.GetPublicKeyBase64()
+}
+
+// Ed25519GetPrivateKeyBase64 returns the base64 encoded private key.
+func Ed25519GetPrivateKeyBase64(kp *Ed25519KeyPair) string {
+	return kp.GetPrivateKeyBase64()
+}
+
+// Ed25519GenKeyPair generates a new Ed25519 key pair.
+func Ed25519GenKeyPair() (*Ed25519KeyPair, error) {
+	return ed25519pkg.GenKeyPair()
+}
+
+// Ed25519GenKeyPairFromSeed derives an Ed25519 key pair from a 32-byte seed.
+func Ed25519GenKeyPairFromSeed(seed []byte) (*Ed25519KeyPair, error) {
+	return ed25519pkg.GenKeyPairFromSeed(seed)
+}
+
+// Ed25519ExtractPublicKey extracts the PKIX public key from a private key.
+func Ed25519ExtractPublicKey(privateKey []byte) ([]byte, error) {
+	return ed25519pkg.ExtractPublicKey(privateKey)
+}
+
+// Ed25519PrivateKeyToSeed returns the raw 32-byte seed of a private key.
+func Ed25519PrivateKeyToSeed(privateKey []byte) ([]byte, error) {
+	return ed25519pkg.PrivateKeyToSeed(privateKey)
+}
+
+// Ed25519PublicKeyToRaw returns the raw 32-byte public key.
+func Ed25519PublicKeyToRaw(publicKey []byte) ([]byte, error) {
+	return ed25519pkg.PublicKeyToRaw(publicKey)
+}
+
+// Ed25519RawToPublicKey converts a raw 32-byte public key to PKIX DER.
+func Ed25519RawToPublicKey(raw []byte) ([]byte, error) {
+	return ed25519pkg.RawToPublicKey(raw)
+}
+
+// Ed25519PrivateKeyToPem encodes a private key as PKCS#8 "PRIVATE KEY" PEM.
+func Ed25519PrivateKeyToPem(privateKey []byte) (string, error) {
+	return ed25519pkg.PrivateKeyToPem(privateKey)
+}
+
+// Ed25519PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func Ed25519PublicKeyToPem(publicKey []byte) (string, error) {
+	return ed25519pkg.PublicKeyToPem(publicKey)
+}
+
+// Ed25519SignBase64 signs data with private key and returns base64 encoded signature.
+func Ed25519SignBase64(data string, privateKey []byte) (string, error) {
+	return ed25519pkg.SignBase64(data, privateKey)
+}
+
+// Ed25519Sign signs data with private key and returns a 64-byte signature.
+func Ed25519Sign(data []byte, privateKey []byte) ([]byte, error) {
+	return ed25519pkg.Sign(data, privateKey)
+}
+
+// Ed25519VerifyFromBase64 verifies base64 encoded signature with public key.
+func Ed25519VerifyFromBase64(data string, publicKey []byte, signatureBase64 string) (bool, error) {
+	return ed25519pkg.VerifyFromBase64(data, publicKey, signatureBase64)
+}
+
+// Ed25519Verify verifies signature with public key.
+func Ed25519Verify(data []byte, publicKey []byte, signature []byte) (bool, error) {
+	return ed25519pkg.Verify(data, publicKey, signature)
+}
+
+// EcdhKeyPair represents a pair of ECDH keys.
+type EcdhKeyPair = ecdhpkg.EcdhKeyPair
+
+// EcdhCurve identifies a key agreement curve.
+type EcdhCurve = ecdhpkg.Curve
+
+// EcdhGetPublicKeyBase64 returns the base64 encoded public key.
+func EcdhGetPublicKeyBase64(kp *EcdhKeyPair) string {
+	return kp.GetPublicKeyBase64()
+}
+
+// EcdhGetPrivateKeyBase64 returns the base64 encoded private key.
+func EcdhGetPrivateKeyBase64(kp *EcdhKeyPair) string {
+	return kp.GetPrivateKeyBase64()
+}
+
+// EcdhParseCurve parses a curve name such as "X25519", "P-256" or "prime256v1".
+func EcdhParseCurve(name string) (EcdhCurve, error) {
+	return ecdhpkg.ParseCurve(name)
+}
+
+// EcdhGenKeyPair generates a new ECDH key pair on the given curve.
+func EcdhGenKeyPair(curve EcdhCurve) (*EcdhKeyPair, error) {
+	return ecdhpkg.GenKeyPair(curve)
+}
+
+// EcdhExtractPublicKey extracts the PKIX public key from a private key.
+func EcdhExtractPublicKey(privateKey []byte) ([]byte, error) {
+	return ecdhpkg.ExtractPublicKey(privateKey)
+}
+
+// EcdhPublicKeyToRaw returns the raw public key: 32 bytes for X25519, a 65-byte uncompressed point for P-256.
+func EcdhPublicKeyToRaw(publicKey []byte) ([]byte, error) {
+	return ecdhpkg.PublicKeyToRaw(publicKey)
+}
+
+// EcdhRawToPublicKey converts a raw X25519 or uncompressed P-256 public key to PKIX DER.
+func EcdhRawToPublicKey(raw []byte) ([]byte, error) {
+	return ecdhpkg.RawToPublicKey(raw)
+}
+
+// EcdhPrivateKeyToPem encodes a private key as PKCS#8 "PRIVATE KEY" PEM.
+func EcdhPrivateKeyToPem(privateKey []byte) (string, error) {
+	return ecdhpkg.PrivateKeyToPem(privateKey)
+}
+
+// EcdhPublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func EcdhPublicKeyToPem(publicKey []byte) (string, error) {
+	return ecdhpkg.PublicKeyToPem(publicKey)
+}
+
+// EcdhSharedSecret computes the raw Diffie-Hellman shared secret between a private key and a peer public key.
+func EcdhSharedSecret(privateKey []byte, peerPublicKey []byte) ([]byte, error) {
+	return ecdhpkg.SharedSecret(privateKey, peerPublicKey)
+}
+
+// EcdhDeriveKey derives a symmetric key from a shared secret using HKDF-SHA256.
+func EcdhDeriveKey(sharedSecret []byte, salt []byte, info []byte, length int) ([]byte, error) {
+	return ecdhpkg.DeriveKey(sharedSecret, salt, info, length)
+}
+
+// EcdhDeriveSessionKey computes the shared secret with the peer and derives a symmetric key from it with HKDF-SHA256.
+func EcdhDeriveSessionKey(privateKey []byte, peerPublicKey []byte, salt []byte, info []byte, length int) ([]byte, error) {
+	return ecdhpkg.DeriveSessionKey(privateKey, peerPublicKey, salt, info, length)
+}
+
+// AesGenKey generates a random AES key of the given size in bits (128, 192 or 256).
+func AesGenKey(keySize int) ([]byte, error) {
+	return aespkg.GenKey(keySize)
+}
+
+// AesEncryptGcmBase64 encrypts data with AES-GCM and returns the base64 encoded nonce||ciphertext||tag.
+func AesEncryptGcmBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	return aespkg.EncryptGcmBase64(data, key, additionalData)
+}
+
+// AesEncryptGcm encrypts data with AES-GCM using a random nonce prepended to the ciphertext.
+func AesEncryptGcm(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return aespkg.EncryptGcm(data, key, additionalData)
+}
+
+// AesDecryptGcmFromBase64 decrypts base64 encoded nonce||ciphertext||tag with AES-GCM.
+func AesDecryptGcmFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	return aespkg.DecryptGcmFromBase64(encrypted, key, additionalData)
+}
+
+// AesDecryptGcm decrypts nonce||ciphertext||tag with AES-GCM.
+func AesDecryptGcm(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return aespkg.DecryptGcm(encryptedData, key, additionalData)
+}
+
+// AesPadding selects the block padding scheme used by CBC mode.
+type AesPadding = aespkg.Padding
+
+// AesParsePadding parses a padding name such as "PKCS7", "PKCS5Padding", "zero" or "NoPadding".
+func AesParsePadding(name string) (AesPadding, error) {
+	return aespkg.ParsePadding(name)
+}
+
+// AesGenIv generates a random 16-byte IV for CBC or CTR mode.
+func AesGenIv() ([]byte, error) {
+	return aespkg.GenIv()
+}
+
+// AesEncryptCbcBase64 encrypts data with AES-CBC and returns the base64 encoded ciphertext.
+func AesEncryptCbcBase64(data []byte, key []byte, iv []byte, padding AesPadding) (string, error) {
+	return aespkg.EncryptCbcBase64(data, key, iv, padding)
+}
+
+// AesEncryptCbcHex encrypts data with AES-CBC and returns the hex encoded ciphertext.
+func AesEncryptCbcHex(data []byte, key []byte, iv []byte, padding AesPadding) (string, error) {
+	return aespkg.EncryptCbcHex(data, key, iv, padding)
+}
+
+// AesEncryptCbc encrypts data with AES-CBC using an explicit 16-byte IV.
+func AesEncryptCbc(data []byte, key []byte, iv []byte, padding AesPadding) ([]byte, error) {
+	return aespkg.EncryptCbc(data, key, iv, padding)
+}
+
+// AesDecryptCbcFromBase64 decrypts base64 encoded AES-CBC ciphertext.
+func AesDecryptCbcFromBase64(encrypted string, key []byte, iv []byte, padding AesPadding) ([]byte, error) {
+	return aespkg.DecryptCbcFromBase64(encrypted, key, iv, padding)
+}
+
+// AesDecryptCbcFromHex decrypts hex encoded AES-CBC ciphertext.
+func AesDecryptCbcFromHex(encrypted string, key []byte, iv []byte, padding AesPadding) ([]byte, error) {
+	return aespkg.DecryptCbcFromHex(encrypted, key, iv, padding)
+}
+
+// AesDecryptCbc decrypts AES-CBC ciphertext with an explicit 16-byte IV.
+func AesDecryptCbc(encryptedData []byte, key []byte, iv []byte, padding AesPadding) ([]byte, error) {
+	return aespkg.DecryptCbc(encryptedData, key, iv, padding)
+}
+
+// AesEncryptCtrBase64 encrypts data with AES-CTR and returns the base64 encoded ciphertext.
+func AesEncryptCtrBase64(data []byte, key []byte, iv []byte) (string, error) {
+	return aespkg.EncryptCtrBase64(data, key, iv)
+}
+
+// AesEncryptCtrHex encrypts data with AES-CTR and returns the hex encoded ciphertext.
+func AesEncryptCtrHex(data []byte, key []byte, iv []byte) (string, error) {
+	return aespkg.EncryptCtrHex(data, key, iv)
+}
+
+// AesEncryptCtr encrypts data with AES-CTR using an explicit 16-byte IV.
+func AesEncryptCtr(data []byte, key []byte, iv []byte) ([]byte, error) {
+	return aespkg.EncryptCtr(data, key, iv)
+}
+
+// AesDecryptCtrFromBase64 decrypts base64 encoded AES-CTR ciphertext.
+func AesDecryptCtrFromBase64(encrypted string, key []byte, iv []byte) ([]byte, error) {
+	return aespkg.DecryptCtrFromBase64(encrypted, key, iv)
+}
+
+// AesDecryptCtrFromHex decrypts hex encoded AES-CTR ciphertext.
+func AesDecryptCtrFromHex(encrypted string, key []byte, iv []byte) ([]byte, error) {
+	return aespkg.DecryptCtrFromHex(encrypted, key, iv)
+}
+
+// AesDecryptCtr decrypts AES-CTR ciphertext with an explicit 16-byte IV.
+func AesDecryptCtr(encryptedData []byte, key []byte, iv []byte) ([]byte, error) {
+	return aespkg.DecryptCtr(encryptedData, key, iv)
+}
+
+// ChaCha20Poly1305GenKey generates a random 32-byte key for ChaCha20-Poly1305 and XChaCha20-Poly1305.
+func ChaCha20Poly1305GenKey() ([]byte, error) {
+	return chachapkg.GenKey()
+}
+
+// ChaCha20Poly1305EncryptBase64 encrypts data with ChaCha20-Poly1305 and returns the base64 encoded nonce||ciphertext||tag.
+func ChaCha20Poly1305EncryptBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	return chachapkg.EncryptBase64(data, key, additionalData)
+}
+
+// ChaCha20Poly1305Encrypt encrypts data with ChaCha20-Poly1305 using a random nonce prepended to the ciphertext.
+func ChaCha20Poly1305Encrypt(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return chachapkg.Encrypt(data, key, additionalData)
+}
+
+// ChaCha20Poly1305DecryptFromBase64 decrypts base64 encoded nonce||ciphertext||tag with ChaCha20-Poly1305.
+func ChaCha20Poly1305DecryptFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	return chachapkg.DecryptFromBase64(encrypted, key, additionalData)
+}
+
+// ChaCha20Poly1305Decrypt decrypts nonce||ciphertext||tag with ChaCha20-Poly1305.
+func ChaCha20Poly1305Decrypt(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return chachapkg.Decrypt(encryptedData, key, additionalData)
+}
+
+// XChaCha20Poly1305EncryptBase64 encrypts data with XChaCha20-Poly1305 and returns the base64 encoded nonce||ciphertext||tag.
+func XChaCha20Poly1305EncryptBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	return chachapkg.EncryptXBase64(data, key, additionalData)
+}
+
+// XChaCha20Poly1305Encrypt encrypts data with XChaCha20-Poly1305 using a random nonce prepended to the ciphertext.
+func XChaCha20Poly1305Encrypt(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return chachapkg.EncryptX(data, key, additionalData)
+}
+
+// XChaCha20Poly1305DecryptFromBase64 decrypts base64 encoded nonce||ciphertext||tag with XChaCha20-Poly1305.
+func XChaCha20Poly1305DecryptFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	return chachapkg.DecryptXFromBase64(encrypted, key, additionalData)
+}
+
+// XChaCha20Poly1305Decrypt decrypts nonce||ciphertext||tag with XChaCha20-Poly1305.
+func XChaCha20Poly1305Decrypt(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return chachapkg.DecryptX(encryptedData, key, additionalData)
+}
+
+// Sm2KeyPair represents a pair of SM2 keys.
+type Sm2KeyPair = sm2pkg.Sm2KeyPair
+
+// Sm2CiphertextOrder selects how the SM2 ciphertext components are concatenated.
+type Sm2CiphertextOrder = sm2pkg.CiphertextOrder
+
+// Sm2GetPublicKeyBase64 returns the base64 encoded public key.
+func Sm2GetPublicKeyBase64(kp *Sm2KeyPair) string {
+	return kp.GetPublicKeyBase64()
+}
+
+// Sm2GetPrivateKeyBase64 returns the base64 encoded private key.
+func Sm2GetPrivateKeyBase64(kp *Sm2KeyPair) string {
+	return kp.GetPrivateKeyBase64()
+}
+
+// Sm2ParseCiphertextOrder parses "C1C3C2" or "C1C2C3".
+func Sm2ParseCiphertextOrder(name string) (Sm2CiphertextOrder, error) {
+	return sm2pkg.ParseCiphertextOrder(name)
+}
+
+// Sm2GenKeyPair generates a new SM2 key pair.
+func Sm2GenKeyPair() (*Sm2KeyPair, error) {
+	return sm2pkg.GenKeyPair()
+}
+
+// Sm2ExtractPublicKey extracts the PKIX public key from a private key.
+func Sm2ExtractPublicKey(privateKey []byte) ([]byte, error) {
+	return sm2pkg.ExtractPublicKey(privateKey)
+}
+
+// Sm2PrivateKeyToPem encodes a private key as PKCS#8 "PRIVATE KEY" PEM.
+func Sm2PrivateKeyToPem(privateKey []byte) (string, error) {
+	return sm2pkg.PrivateKeyToPem(privateKey)
+}
+
+// Sm2PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func Sm2PublicKeyToPem(publicKey []byte) (string, error) {
+	return sm2pkg.PublicKeyToPem(publicKey)
+}
+
+// Sm2SignBase64 signs data with private key and returns base64 encoded DER signature.
+func Sm2SignBase64(data string, privateKey []byte, uid []byte) (string, error) {
+	return sm2pkg.SignBase64(data, privateKey, uid)
+}
+
+// Sm2Sign signs data with private key and returns an ASN.1 DER signature.
+func Sm2Sign(data []byte, privateKey []byte, uid []byte) ([]byte, error) {
+	return sm2pkg.Sign(data, privateKey, uid)
+}
+
+// Sm2VerifyFromBase64 verifies base64 encoded DER signature with public key.
+func Sm2VerifyFromBase64(data string, publicKey []byte, signatureBase64 string, uid []byte) (bool, error) {
+	return sm2pkg.VerifyFromBase64(data, publicKey, signatureBase64, uid)
+}
+
+// Sm2Verify verifies an ASN.1 DER signature with public key.
+func Sm2Verify(data []byte, publicKey []byte, signature []byte, uid []byte) (bool, error) {
+	return sm2pkg.Verify(data, publicKey, signature, uid)
+}
+
+// Sm2EncryptBase64 encrypts data with public key and returns the base64 encoded ciphertext.
+func Sm2EncryptBase64(data []byte, publicKey []byte, order Sm2CiphertextOrder) (string, error) {
+	return sm2pkg.EncryptBase64(data, publicKey, order)
+}
+
+// Sm2Encrypt encrypts data with public key.
+func Sm2Encrypt(data []byte, publicKey []byte, order Sm2CiphertextOrder) ([]byte, error) {
+	return sm2pkg.Encrypt(data, publicKey, order)
+}
+
+// Sm2DecryptFromBase64 decrypts base64 encoded ciphertext with private key.
+func Sm2DecryptFromBase64(encrypted string, privateKey []byte, order Sm2CiphertextOrder) ([]byte, error) {
+	return sm2pkg.DecryptFromBase64(encrypted, privateKey, order)
+}
+
+// Sm2Decrypt decrypts ciphertext with private key.
+func Sm2Decrypt(encryptedData []byte, privateKey []byte, order Sm2CiphertextOrder) ([]byte, error) {
+	return sm2pkg.Decrypt(encryptedData, privateKey, order)
+}
+
+// Sm3Sum returns the SM3 digest of data.
+func Sm3Sum(data []byte) []byte {
+	return sm3pkg.Sum(data)
+}
+
+// Sm3SumHex returns the hex encoded SM3 digest of data.
+func Sm3SumHex(data []byte) string {
+	return sm3pkg.SumHex(data)
+}
+
+// Sm3Hmac returns the HMAC-SM3 of data with key.
+func Sm3Hmac(data []byte, key []byte) []byte {
+	return sm3pkg.Hmac(data, key)
+}
+
+// Sm3HmacHex returns the hex encoded HMAC-SM3 of data with key.
+func Sm3HmacHex(data []byte, key []byte) string {
+	return sm3pkg.HmacHex(data, key)
+}
+
+// Sm3VerifyHmac reports whether mac is the HMAC-SM3 of data with key.
+func Sm3VerifyHmac(data []byte, key []byte, mac []byte) bool {
+	return sm3pkg.VerifyHmac(data, key, mac)
+}
+
+// Sm4Padding selects the block padding scheme used by SM4 ECB and CBC modes.
+type Sm4Padding = sm4pkg.Padding
+
+// Sm4ParsePadding parses a padding name such as "PKCS7", "PKCS5Padding", "zero" or "NoPadding".
+func Sm4ParsePadding(name string) (Sm4Padding, error) {
+	return sm4pkg.ParsePadding(name)
+}
+
+// Sm4GenKey generates a random 16-byte SM4 key.
+func Sm4GenKey() ([]byte, error) {
+	return sm4pkg.GenKey()
+}
+
+// Sm4GenIv generates a random 16-byte IV for CBC mode.
+func Sm4GenIv() ([]byte, error) {
+	return sm4pkg.GenIv()
+}
+
+// Sm4EncryptEcbBase64 encrypts data with SM4-ECB and returns the base64 encoded ciphertext.
+func Sm4EncryptEcbBase64(data []byte, key []byte, padding Sm4Padding) (string, error) {
+	return sm4pkg.EncryptEcbBase64(data, key, padding)
+}
+
+// Sm4EncryptEcbHex encrypts data with SM4-ECB and returns the hex encoded ciphertext.
+func Sm4EncryptEcbHex(data []byte, key []byte, padding Sm4Padding) (string, error) {
+	return sm4pkg.EncryptEcbHex(data, key, padding)
+}
+
+// Sm4EncryptEcb encrypts data with SM4-ECB.
+func Sm4EncryptEcb(data []byte, key []byte, padding Sm4Padding) ([]byte, error) {
+	return sm4pkg.EncryptEcb(data, key, padding)
+}
+
+// Sm4DecryptEcbFromBase64 decrypts base64 encoded SM4-ECB ciphertext.
+func Sm4DecryptEcbFromBase64(encrypted string, key []byte, padding Sm4Padding) ([]byte, error) {
+	return sm4pkg.DecryptEcbFromBase64(encrypted, key, padding)
+}
+
+// Sm4DecryptEcbFromHex decrypts hex encoded SM4-ECB ciphertext.
+func Sm4DecryptEcbFromHex(encrypted string, key []byte, padding Sm4Padding) ([]byte, error) {
+	return sm4pkg.DecryptEcbFromHex(encrypted, key, padding)
+}
+
+// Sm4DecryptEcb decrypts SM4-ECB ciphertext.
+func Sm4DecryptEcb(encryptedData []byte, key []byte, padding Sm4Padding) ([]byte, error) {
+	return sm4pkg.DecryptEcb(encryptedData, key, padding)
+}
+
+// Sm4EncryptCbcBase64 encrypts data with SM4-CBC and returns the base64 encoded ciphertext.
+func Sm4EncryptCbcBase64(data []byte, key []byte, iv []byte, padding Sm4Padding) (string, error) {
+	return sm4pkg.EncryptCbcBase64(data, key, iv, padding)
+}
+
+// Sm4EncryptCbcHex encrypts data with SM4-CBC and returns the hex encoded ciphertext.
+func Sm4EncryptCbcHex(data []byte, key []byte, iv []byte, padding Sm4Padding) (string, error) {
+	return sm4pkg.EncryptCbcHex(data, key, iv, padding)
+}
+
+// Sm4EncryptCbc encrypts data with SM4-CBC.
+func Sm4EncryptCbc(data []byte, key []byte, iv []byte, padding Sm4Padding) ([]byte, error) {
+	return sm4pkg.EncryptCbc(data, key, iv, padding)
+}
+
+// Sm4DecryptCbcFromBase64 decrypts base64 encoded SM4-CBC ciphertext.
+func Sm4DecryptCbcFromBase64(encrypted string, key []byte, iv []byte, padding Sm4Padding) ([]byte, error) {
+	return sm4pkg.DecryptCbcFromBase64(encrypted, key, iv, padding)
+}
+
+// Sm4DecryptCbcFromHex decrypts hex encoded SM4-CBC ciphertext.
+func Sm4DecryptCbcFromHex(encrypted string, key []byte, iv []byte, padding Sm4Padding) ([]byte, error) {
+	return sm4pkg.DecryptCbcFromHex(encrypted, key, iv, padding)
+}
+
+// Sm4DecryptCbc decrypts SM4-CBC ciphertext.
+func Sm4DecryptCbc(encryptedData []byte, key []byte, iv []byte, padding Sm4Padding) ([]byte, error) {
+	return sm4pkg.DecryptCbc(encryptedData, key, iv, padding)
+}
+
+// Sm4EncryptGcmBase64 encrypts data with SM4-GCM and returns the base64 encoded nonce||ciphertext||tag.
+func Sm4EncryptGcmBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	return sm4pkg.EncryptGcmBase64(data, key, additionalData)
+}
+
+// Sm4EncryptGcm encrypts data with SM4-GCM using a random nonce prepended to the ciphertext.
+func Sm4EncryptGcm(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return sm4pkg.EncryptGcm(data, key, additionalData)
+}
+
+// Sm4DecryptGcmFromBase64 decrypts base64 encoded nonce||ciphertext||tag with SM4-GCM.
+func Sm4DecryptGcmFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	return sm4pkg.DecryptGcmFromBase64(encrypted, key, additionalData)
+}
+
+// Sm4DecryptGcm decrypts nonce||ciphertext||tag with SM4-GCM.
+func Sm4DecryptGcm(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return sm4pkg.DecryptGcm(encryptedData, key, additionalData)
+}
+
+// HashAlgorithm identifies a hash algorithm.
+type HashAlgorithm = hashpkg.Algorithm
+
+// HashHasher computes a digest or HMAC incrementally.
+type HashHasher = hashpkg.Hasher
+
+// HashParseAlgorithm parses a hash algorithm name such as "SHA-256", "SHA3-512" or "BLAKE2b-256".
+func HashParseAlgorithm(name string) (HashAlgorithm, error) {
+	return hashpkg.ParseAlgorithm(name)
+}
+
+// HashSum returns the digest of data.
+func HashSum(data []byte, algorithm HashAlgorithm) ([]byte, error) {
+	return hashpkg.Sum(data, algorithm)
+}
+
+// HashSumHex returns the hex encoded digest of data.
+func HashSumHex(data []byte, algorithm HashAlgorithm) (string, error) {
+	return hashpkg.SumHex(data, algorithm)
+}
+
+// HashSumBase64 returns the base64 encoded digest of data.
+func HashSumBase64(data []byte, algorithm HashAlgorithm) (string, error) {
+	return hashpkg.SumBase64(data, algorithm)
+}
+
+// HashHmac returns the HMAC of data with key.
+func HashHmac(data []byte, key []byte, algorithm HashAlgorithm) ([]byte, error) {
+	return hashpkg.Hmac(data, key, algorithm)
+}
+
+// HashHmacHex returns the hex encoded HMAC of data with key.
+func HashHmacHex(data []byte, key []byte, algorithm HashAlgorithm) (string, error) {
+	return hashpkg.HmacHex(data, key, algorithm)
+}
+
+// HashHmacBase64 returns the base64 encoded HMAC of data with key.
+func HashHmacBase64(data []byte, key []byte, algorithm HashAlgorithm) (string, error) {
+	return hashpkg.HmacBase64(data, key, algorithm)
+}
+
+// HashVerifyHmac reports whether mac is the HMAC of data with key, using constant-time comparison.
+func HashVerifyHmac(data []byte, key []byte, mac []byte, algorithm HashAlgorithm) (bool, error) {
+	return hashpkg.VerifyHmac(data, key, mac, algorithm)
+}
+
+// HashNew creates a Hasher computing the digest with the given algorithm.
+func HashNew(algorithm HashAlgorithm) (*HashHasher, error) {
+	return hashpkg.New(algorithm)
+}
+
+// HashNewHmac creates a Hasher computing the HMAC with key and the given algorithm.
+func HashNewHmac(key []byte, algorithm HashAlgorithm) (*HashHasher, error) {
+	return hashpkg.NewHmac(key, algorithm)
+}
+
+// KdfPbkdf2Options configures KdfPbkdf2.
+type KdfPbkdf2Options = kdfpkg.Pbkdf2Options
+
+// KdfScryptOptions configures KdfScrypt.
+type KdfScryptOptions = kdfpkg.ScryptOptions
+
+// KdfArgon2Options configures KdfArgon2id and KdfHashPassword.
+type KdfArgon2Options = kdfpkg.Argon2Options
+
+// KdfPbkdf2 derives a key from password and salt with PBKDF2.
+func KdfPbkdf2(password []byte, salt []byte, opts *KdfPbkdf2Options) ([]byte, error) {
+	return kdfpkg.Pbkdf2(password, salt, opts)
+}
+
+// KdfScrypt derives a key from password and salt with scrypt.
+func KdfScrypt(password []byte, salt []byte, opts *KdfScryptOptions) ([]byte, error) {
+	return kdfpkg.Scrypt(password, salt, opts)
+}
+
+// KdfArgon2id derives a key from password and salt with Argon2id.
+func KdfArgon2id(password []byte, salt []byte, opts *KdfArgon2Options) ([]byte, error) {
+	return kdfpkg.Argon2id(password, salt, opts)
+}
+
+// KdfHkdf derives a key of the given length from secret with HKDF.
+func KdfHkdf(secret []byte, salt []byte, info []byte, length int, algorithm HashAlgorithm) ([]byte, error) {
+	return kdfpkg.Hkdf(secret, salt, info, length, algorithm)
+}
+
+// KdfHashPassword hashes password with Argon2id and a random salt, returning a PHC string.
+func KdfHashPassword(password string, opts *KdfArgon2Options) (string, error) {
+	return kdfpkg.HashPassword(password, opts)
+}
+
+// KdfVerifyPassword verifies password against an Argon2id PHC string.
+func KdfVerifyPassword(password string, encodedHash string) (bool, error) {
+	return kdfpkg.VerifyPassword(password, encodedHash)
+}
+
+// RandomBytes returns n cryptographically secure random bytes.
+func RandomBytes(n int) ([]byte, error) {
+	return randompkg.Bytes(n)
+}
+
+// RandomInt returns a uniformly distributed random integer in the closed range [min, max].
+func RandomInt(min int64, max int64) (int64, error) {
+	return randompkg.Int(min, max)
+}
+
+// RandomToken returns a URL-safe base64 encoding of n random bytes without padding.
+func RandomToken(n int) (string, error) {
+	return randompkg.Token(n)
+}
+
+// RandomUuidV4 returns a random (version 4) UUID in canonical form.
+func RandomUuidV4() (string, error) {
+	return randompkg.UuidV4()
+}
+
+// RandomUuidV7 returns a time-ordered (version 7) UUID in canonical form.
+func RandomUuidV7() (string, error) {
+	return randompkg.UuidV7()
+}
+
+// JwkFromPublicKey converts an RSA public key (DER or PEM) to a JSON encoded JWK.
+func JwkFromPublicKey(publicKey []byte, kid string) (string, error) {
+	return jwkpkg.FromPublicKey(publicKey, kid)
+}
+
+// JwkFromPrivateKey converts an RSA private key (DER or PEM) to a JSON encoded JWK.
+func JwkFromPrivateKey(privateKey []byte, kid string) (string, error) {
+	return jwkpkg.FromPrivateKey(privateKey, kid)
+}
+
+// JwkToPublicKey converts a JSON encoded JWK to a PKIX DER public key.
+func JwkToPublicKey(jwk string) ([]byte, error) {
+	return jwkpkg.ToPublicKey(jwk)
+}
+
+// JwkToPrivateKey converts a JSON encoded private JWK to a PKCS#8 DER private key.
+func JwkToPrivateKey(jwk string) ([]byte, error) {
+	return jwkpkg.ToPrivateKey(jwk)
+}
+
+// JwkThumbprint returns the RFC 7638 SHA-256 thumbprint of a JSON encoded JWK.
+func JwkThumbprint(jwk string) (string, error) {
+	return jwkpkg.Thumbprint(jwk)
+}
+
+// JwksAddKey adds a JSON encoded JWK to a JSON encoded JWKS.
+func JwksAddKey(jwks string, jwk string) (string, error) {
+	return jwkpkg.AddToSet(jwks, jwk)
+}
+
+// JwksLookupKey returns the JSON encoded JWK with the given kid from a JSON encoded JWKS.
+func JwksLookupKey(jwks string, kid string) (string, error) {
+	return jwkpkg.LookupKey(jwks, kid)
+}
+
+// JwtAlgorithm is a JWS "alg" header value.
+type JwtAlgorithm = jwtpkg.Algorithm
+
+// JwtSignOptions configures JwtSign.
+type JwtSignOptions = jwtpkg.SignOptions
+
+// JwtVerifyOptions configures JwtVerify.
+type JwtVerifyOptions = jwtpkg.VerifyOptions
+
+// JwtSign signs the JSON encoded claims with private key and returns the compact token.
+func JwtSign(claims string, privateKey []byte, opts *JwtSignOptions) (string, error) {
+	return jwtpkg.Sign(claims, privateKey, opts)
+}
+
+// JwtVerify verifies the token with public key, validates the registered claims and returns the JSON encoded claims.
+func JwtVerify(token string, publicKey []byte, opts *JwtVerifyOptions) (string, error) {
+	return jwtpkg.Verify(token, publicKey, opts)
+}
+
+// JwtDecodeHeader returns the JSON encoded header without verifying the token.
+func JwtDecodeHeader(token string) (string, error) {
+	return jwtpkg.DecodeHeader(token)
+}
+
+// JwtDecodeClaims returns the JSON encoded claims without verifying the token.
+func JwtDecodeClaims(token string) (string, error) {
+	return jwtpkg.DecodeClaims(token)
+}
@@ -0,0 +1,138 @@
+// Package aes provides AES-128/192/256 symmetric encryption in GCM, CBC and CTR modes.
+// GCM模式使用随机nonce并将其置于密文之前，支持可选的附加认证数据；
+// CBC和CTR模式使用显式传入的IV，用于与旧系统互通。
+package aes
+
+import (
+	internalaes "go-secure-utils/internal/crypto/aes"
+)
+
+// Supported key sizes in bits.
+const (
+	KeySize128 = internalaes.KeySize128
+	KeySize192 = internalaes.KeySize192
+	KeySize256 = internalaes.KeySize256
+)
+
+// GCM nonce and tag sizes in bytes.
+const (
+	GcmNonceSize = internalaes.GcmNonceSize
+	GcmTagSize   = internalaes.GcmTagSize
+)
+
+// IvSize is the IV length in bytes for CBC and CTR modes.
+const IvSize = internalaes.IvSize
+
+// Padding selects the block padding scheme used by CBC mode.
+type Padding = internalaes.Padding
+
+// Supported padding schemes.
+const (
+	PaddingPkcs7 = internalaes.PaddingPkcs7
+	PaddingZero  = internalaes.PaddingZero
+	PaddingNone  = internalaes.PaddingNone
+)
+
+// ParsePadding parses a padding name such as "PKCS7", "PKCS5Padding", "zero" or "NoPadding".
+func ParsePadding(name string) (Padding, error) {
+	return internalaes.ParsePadding(name)
+}
+
+// GenKey generates a random AES key of the given size in bits (128, 192 or 256).
+func GenKey(keySize int) ([]byte, error) {
+	// Default key size if not provided
+	if keySize == 0 {
+		keySize = KeySize256
+	}
+
+	return internalaes.GenKey(keySize)
+}
+
+// EncryptGcmBase64 encrypts data with AES-GCM and returns the base64 encoded nonce||ciphertext||tag.
+func EncryptGcmBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	return internalaes.EncryptGcmBase64(data, key, additionalData)
+}
+
+// EncryptGcm encrypts data with AES-GCM using a random nonce.
+// 输出格式为nonce(12) || ciphertext || tag(16)，additionalData可为空。
+func EncryptGcm(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return internalaes.EncryptGcm(data, key, additionalData)
+}
+
+// DecryptGcmFromBase64 decrypts base64 encoded nonce||ciphertext||tag with AES-GCM.
+func DecryptGcmFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	return internalaes.DecryptGcmFromBase64(encrypted, key, additionalData)
+}
+
+// DecryptGcm decrypts nonce||ciphertext||tag produced by EncryptGcm.
+// additionalData必须与加密时一致。
+func DecryptGcm(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return internalaes.DecryptGcm(encryptedData, key, additionalData)
+}
+
+// GenIv generates a random 16-byte IV for CBC or CTR mode.
+func GenIv() ([]byte, error) {
+	return internalaes.GenIv()
+}
+
+// EncryptCbcBase64 encrypts data with AES-CBC and returns the base64 encoded ciphertext.
+func EncryptCbcBase64(data []byte, key []byte, iv []byte, padding Padding) (string, error) {
+	return internalaes.EncryptCbcBase64(data, key, iv, padding)
+}
+
+// EncryptCbcHex encrypts data with AES-CBC and returns the hex encoded ciphertext.
+func EncryptCbcHex(data []byte, key []byte, iv []byte, padding Padding) (string, error) {
+	return internalaes.EncryptCbcHex(data, key, iv, padding)
+}
+
+// EncryptCbc encrypts data with AES-CBC using an explicit 16-byte IV.
+// 输出不包含IV，与Java的"AES/CBC/PKCS5Padding"兼容。
+func EncryptCbc(data []byte, key []byte, iv []byte, padding Padding) ([]byte, error) {
+	return internalaes.EncryptCbc(data, key, iv, padding)
+}
+
+// DecryptCbcFromBase64 decrypts base64 encoded AES-CBC ciphertext.
+func DecryptCbcFromBase64(encrypted string, key []byte, iv []byte, padding Padding) ([]byte, error) {
+	return internalaes.DecryptCbcFromBase64(encrypted, key, iv, padding)
+}
+
+// DecryptCbcFromHex decrypts hex encoded AES-CBC ciphertext.
+func DecryptCbcFromHex(encrypted string, key []byte, iv []byte, padding Padding) ([]byte, error) {
+	return internalaes.DecryptCbcFromHex(encrypted, key, iv, padding)
+}
+
+// DecryptCbc decrypts AES-CBC ciphertext with an explicit 16-byte IV.
+func DecryptCbc(encryptedData []byte, key []byte, iv []byte, padding Padding) ([]byte, error) {
+	return internalaes.DecryptCbc(encryptedData, key, iv, padding)
+}
+
+// EncryptCtrBase64 encrypts data with AES-CTR and returns the base64 encoded ciphertext.
+func EncryptCtrBase64(data []byte, key []byte, iv []byte) (string, error) {
+	return internalaes.EncryptCtrBase64(data, key, iv)
+}
+
+// EncryptCtrHex encrypts data with AES-CTR and returns the hex encoded ciphertext.
+func EncryptCtrHex(data []byte, key []byte, iv []byte) (string, error) {
+	return internalaes.EncryptCtrHex(data, key, iv)
+}
+
+// EncryptCtr encrypts data with AES-CTR using an explicit 16-byte initial counter block.
+// 同一密钥下IV不可重复使用。
+func EncryptCtr(data []byte, key []byte, iv []byte) ([]byte, error) {
+	return internalaes.EncryptCtr(data, key, iv)
+}
+
+// DecryptCtrFromBase64 decrypts base64 encoded AES-CTR ciphertext.
+func DecryptCtrFromBase64(encrypted string, key []byte, iv []byte) ([]byte, error) {
+	return internalaes.DecryptCtrFromBase64(encrypted, key, iv)
+}
+
+// DecryptCtrFromHex decrypts hex encoded AES-CTR ciphertext.
+func DecryptCtrFromHex(encrypted string, key []byte, iv []byte) ([]byte, error) {
+	return internalaes.DecryptCtrFromHex(encrypted, key, iv)
+}
+
+// DecryptCtr decrypts AES-CTR ciphertext with an explicit 16-byte initial counter block.
+func DecryptCtr(encryptedData []byte, key []byte, iv []byte) ([]byte, error) {
+	return internalaes.DecryptCtr(encryptedData, key, iv)
+}
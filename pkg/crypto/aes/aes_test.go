@@ -0,0 +1,295 @@
+package aes
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestGenKey(t *testing.T) {
+	for keySize, want := range map[int]int{0: 32, KeySize128: 16, KeySize192: 24, KeySize256: 32} {
+		key, err := GenKey(keySize)
+		if err != nil {
+			t.Fatalf("GenKey(%d) failed: %v", keySize, err)
+		}
+
+		if len(key) != want {
+			t.Errorf("GenKey(%d) length = %d, want %d", keySize, len(key), want)
+		}
+	}
+
+	if _, err := GenKey(512); err == nil {
+		t.Error("GenKey should reject invalid key size")
+	}
+}
+
+func TestEncryptAndDecryptGcm(t *testing.T) {
+	additionalData := []byte("header")
+
+	for _, keySize := range []int{KeySize128, KeySize192, KeySize256} {
+		key, err := GenKey(keySize)
+		if err != nil {
+			t.Fatalf("GenKey failed: %v", err)
+		}
+
+		encrypted, err := EncryptGcm([]byte(content), key, additionalData)
+		if err != nil {
+			t.Fatalf("EncryptGcm failed: %v", err)
+		}
+
+		if len(encrypted) != GcmNonceSize+len(content)+GcmTagSize {
+			t.Errorf("EncryptGcm length = %d, want %d", len(encrypted), GcmNonceSize+len(content)+GcmTagSize)
+		}
+
+		decrypted, err := DecryptGcm(encrypted, key, additionalData)
+		if err != nil {
+			t.Fatalf("DecryptGcm failed: %v", err)
+		}
+
+		if string(decrypted) != content {
+			t.Errorf("Decrypted content does not match original: %s", decrypted)
+		}
+
+		// 附加数据不一致时解密失败
+		if _, err := DecryptGcm(encrypted, key, []byte("other")); err == nil {
+			t.Error("DecryptGcm with wrong additional data should fail")
+		}
+
+		// 篡改密文后解密失败
+		encrypted[GcmNonceSize] ^= 1
+		if _, err := DecryptGcm(encrypted, key, additionalData); err == nil {
+			t.Error("DecryptGcm with tampered ciphertext should fail")
+		}
+	}
+}
+
+func TestEncryptAndDecryptGcmBase64(t *testing.T) {
+	key, err := GenKey(0)
+	if err != nil {
+		t.Fatalf("GenKey failed: %v", err)
+	}
+
+	// 测试普通数据和空数据
+	for _, data := range [][]byte{[]byte(content), {}} {
+		encrypted, err := EncryptGcmBase64(data, key, nil)
+		if err != nil {
+			t.Fatalf("EncryptGcmBase64 failed: %v", err)
+		}
+
+		decrypted, err := DecryptGcmFromBase64(encrypted, key, nil)
+		if err != nil {
+			t.Fatalf("DecryptGcmFromBase64 failed: %v", err)
+		}
+
+		if !bytes.Equal(decrypted, data) {
+			t.Errorf("Decrypted content does not match original: %s", decrypted)
+		}
+	}
+}
+
+func TestDecryptGcmKnownAnswer(t *testing.T) {
+	// NIST GCM规范测试用例2：全零密钥、nonce和明文
+	encrypted := mustDecodeHex("000000000000000000000000" + "0388dace60b6a392f328c2b971b2fe78" + "ab6e47d42cec13bdf53a67b21257bddf")
+
+	decrypted, err := DecryptGcm(encrypted, make([]byte, 16), nil)
+	if err != nil {
+		t.Fatalf("DecryptGcm failed: %v", err)
+	}
+
+	if !bytes.Equal(decrypted, make([]byte, 16)) {
+		t.Errorf("DecryptGcm = %x, want zeros", decrypted)
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	if _, err := EncryptGcm([]byte(content), make([]byte, 15), nil); err == nil {
+		t.Error("EncryptGcm should reject invalid key length")
+	}
+
+	if _, err := DecryptGcm(make([]byte, GcmNonceSize+GcmTagSize-1), make([]byte, 32), nil); err == nil {
+		t.Error("DecryptGcm should reject short ciphertext")
+	}
+
+	if _, err := DecryptGcmFromBase64("not base64!", make([]byte, 32), nil); err == nil {
+		t.Error("DecryptGcmFromBase64 should reject invalid base64")
+	}
+}
+
+func TestEncryptAndDecryptCbc(t *testing.T) {
+	key, err := GenKey(0)
+	if err != nil {
+		t.Fatalf("GenKey failed: %v", err)
+	}
+	iv, err := GenIv()
+	if err != nil {
+		t.Fatalf("GenIv failed: %v", err)
+	}
+
+	// 测试空数据、非整块数据和整块数据
+	for _, data := range [][]byte{{}, []byte(content), bytes.Repeat([]byte("a"), 32)} {
+		for _, padding := range []Padding{PaddingPkcs7, PaddingZero} {
+			encrypted, err := EncryptCbc(data, key, iv, padding)
+			if err != nil {
+				t.Fatalf("EncryptCbc(%v) failed: %v", padding, err)
+			}
+
+			if len(encrypted)%16 != 0 {
+				t.Errorf("EncryptCbc(%v) length = %d, want multiple of 16", padding, len(encrypted))
+			}
+
+			decrypted, err := DecryptCbc(encrypted, key, iv, padding)
+			if err != nil {
+				t.Fatalf("DecryptCbc(%v) failed: %v", padding, err)
+			}
+
+			if !bytes.Equal(decrypted, data) {
+				t.Errorf("Decrypted content does not match original: %s", decrypted)
+			}
+		}
+	}
+
+	// 无填充时数据必须为整块
+	if _, err := EncryptCbc([]byte(content), key, iv, PaddingNone); err == nil {
+		t.Error("EncryptCbc without padding should reject partial block")
+	}
+
+	if _, err := EncryptCbc([]byte(content), key, iv[:8], PaddingPkcs7); err == nil {
+		t.Error("EncryptCbc should reject invalid IV length")
+	}
+}
+
+func TestCbcKnownAnswer(t *testing.T) {
+	// NIST SP 800-38A F.2.1 CBC-AES128.Encrypt 第一个分组
+	key := mustDecodeHex("2b7e151628aed2a6abf7158809cf4f3c")
+	iv := mustDecodeHex("000102030405060708090a0b0c0d0e0f")
+
+	encrypted, err := EncryptCbcHex(mustDecodeHex("6bc1bee22e409f96e93d7e117393172a"), key, iv, PaddingNone)
+	if err != nil {
+		t.Fatalf("EncryptCbcHex failed: %v", err)
+	}
+
+	if want := "7649abac8119b246cee98e9b12e9197d"; encrypted != want {
+		t.Errorf("EncryptCbcHex = %s, want %s", encrypted, want)
+	}
+}
+
+func TestCbcOpenSslCompatibility(t *testing.T) {
+	// openssl enc -aes-256-cbc 使用PKCS#7填充生成的密文
+	key := mustDecodeHex(openSslKeyHex)
+	iv := mustDecodeHex(openSslIvHex)
+
+	encrypted, err := EncryptCbcBase64([]byte("hello legacy cbc"), key, iv, PaddingPkcs7)
+	if err != nil {
+		t.Fatalf("EncryptCbcBase64 failed: %v", err)
+	}
+
+	if encrypted != openSslCbcBase64 {
+		t.Errorf("EncryptCbcBase64 = %s, want %s", encrypted, openSslCbcBase64)
+	}
+
+	decrypted, err := DecryptCbcFromHex(openSslCbcHex, key, iv, PaddingPkcs7)
+	if err != nil {
+		t.Fatalf("DecryptCbcFromHex failed: %v", err)
+	}
+
+	if string(decrypted) != "hello" {
+		t.Errorf("DecryptCbcFromHex = %s, want hello", decrypted)
+	}
+
+	// 错误的密钥导致填充校验失败
+	if _, err := DecryptCbcFromBase64(openSslCbcBase64, make([]byte, 32), iv, PaddingPkcs7); err == nil {
+		t.Error("DecryptCbcFromBase64 with wrong key should fail")
+	}
+}
+
+func TestCtrKnownAnswer(t *testing.T) {
+	// NIST SP 800-38A F.5.1 CTR-AES128.Encrypt 第一个分组
+	key := mustDecodeHex("2b7e151628aed2a6abf7158809cf4f3c")
+	iv := mustDecodeHex("f0f1f2f3f4f5f6f7f8f9fafbfcfdfeff")
+
+	encrypted, err := EncryptCtrHex(mustDecodeHex("6bc1bee22e409f96e93d7e117393172a"), key, iv)
+	if err != nil {
+		t.Fatalf("EncryptCtrHex failed: %v", err)
+	}
+
+	if want := "874d6191b620e3261bef6864990db6ce"; encrypted != want {
+		t.Errorf("EncryptCtrHex = %s, want %s", encrypted, want)
+	}
+
+	decrypted, err := DecryptCtrFromHex(encrypted, key, iv)
+	if err != nil {
+		t.Fatalf("DecryptCtrFromHex failed: %v", err)
+	}
+
+	if hex.EncodeToString(decrypted) != "6bc1bee22e409f96e93d7e117393172a" {
+		t.Errorf("DecryptCtrFromHex = %x", decrypted)
+	}
+}
+
+func TestEncryptAndDecryptCtrBase64(t *testing.T) {
+	key, err := GenKey(KeySize128)
+	if err != nil {
+		t.Fatalf("GenKey failed: %v", err)
+	}
+	iv, err := GenIv()
+	if err != nil {
+		t.Fatalf("GenIv failed: %v", err)
+	}
+
+	encrypted, err := EncryptCtrBase64([]byte(content), key, iv)
+	if err != nil {
+		t.Fatalf("EncryptCtrBase64 failed: %v", err)
+	}
+
+	decrypted, err := DecryptCtrFromBase64(encrypted, key, iv)
+	if err != nil {
+		t.Fatalf("DecryptCtrFromBase64 failed: %v", err)
+	}
+
+	if string(decrypted) != content {
+		t.Errorf("Decrypted content does not match original: %s", decrypted)
+	}
+}
+
+func TestParsePadding(t *testing.T) {
+	for name, want := range map[string]Padding{
+		"PKCS7":        PaddingPkcs7,
+		"PKCS5Padding": PaddingPkcs7,
+		"zero":         PaddingZero,
+		"NoPadding":    PaddingNone,
+		"pkcs5padding": PaddingPkcs7,
+		"NOPADDING":    PaddingNone,
+		"ZeroPADDING":  PaddingZero,
+	} {
+		got, err := ParsePadding(name)
+		if err != nil {
+			t.Fatalf("ParsePadding(%q) failed: %v", name, err)
+		}
+		if got != want {
+			t.Errorf("ParsePadding(%q) = %v, want %v", name, got, want)
+		}
+	}
+
+	if _, err := ParsePadding("ISO10126"); err == nil {
+		t.Error("ParsePadding should reject unsupported padding")
+	}
+}
+
+const content = "hello aes"
+
+// OpenSSL生成的AES-256-CBC测试数据
+const (
+	openSslKeyHex    = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	openSslIvHex     = "0f0e0d0c0b0a09080706050403020100"
+	openSslCbcBase64 = "e8lqKSN85FTXqB43E3AJefjK1FChwjaiMWA94SRqVyo="
+	openSslCbcHex    = "2e5a65859a9f16ccfc0dddb5306145a9"
+)
+
+// mustDecodeHex 是一个辅助函数，用于从十六进制字符串解码数据
+func mustDecodeHex(s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
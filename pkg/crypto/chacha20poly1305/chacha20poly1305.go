@@ -0,0 +1,62 @@
+// Package chacha20poly1305 provides ChaCha20-Poly1305 and XChaCha20-Poly1305 authenticated encryption.
+// 适用于没有AES硬件加速的设备，nonce随机生成并置于密文之前，支持可选的附加认证数据。
+package chacha20poly1305
+
+import (
+	internalchacha "go-secure-utils/internal/crypto/chacha20poly1305"
+)
+
+// Key, nonce and tag sizes in bytes.
+const (
+	KeySize    = internalchacha.KeySize
+	NonceSize  = internalchacha.NonceSize
+	NonceSizeX = internalchacha.NonceSizeX
+	Overhead   = internalchacha.Overhead
+)
+
+// GenKey generates a random 32-byte key.
+func GenKey() ([]byte, error) {
+	return internalchacha.GenKey()
+}
+
+// EncryptBase64 encrypts data with ChaCha20-Poly1305 and returns the base64 encoded nonce||ciphertext||tag.
+func EncryptBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	return internalchacha.EncryptBase64(data, key, additionalData)
+}
+
+// Encrypt encrypts data with ChaCha20-Poly1305 (RFC 8439) using a random 12-byte nonce.
+// 输出格式为nonce(12) || ciphertext || tag(16)，additionalData可为空。
+func Encrypt(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return internalchacha.Encrypt(data, key, additionalData)
+}
+
+// DecryptFromBase64 decrypts base64 encoded nonce||ciphertext||tag with ChaCha20-Poly1305.
+func DecryptFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	return internalchacha.DecryptFromBase64(encrypted, key, additionalData)
+}
+
+// Decrypt decrypts nonce||ciphertext||tag produced by Encrypt.
+func Decrypt(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return internalchacha.Decrypt(encryptedData, key, additionalData)
+}
+
+// EncryptXBase64 encrypts data with XChaCha20-Poly1305 and returns the base64 encoded nonce||ciphertext||tag.
+func EncryptXBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	return internalchacha.EncryptXBase64(data, key, additionalData)
+}
+
+// EncryptX encrypts data with XChaCha20-Poly1305 using a random 24-byte nonce.
+// 输出格式为nonce(24) || ciphertext || tag(16)。
+func EncryptX(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return internalchacha.EncryptX(data, key, additionalData)
+}
+
+// DecryptXFromBase64 decrypts base64 encoded nonce||ciphertext||tag with XChaCha20-Poly1305.
+func DecryptXFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	return internalchacha.DecryptXFromBase64(encrypted, key, additionalData)
+}
+
+// DecryptX decrypts nonce||ciphertext||tag produced by EncryptX.
+func DecryptX(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return internalchacha.DecryptX(encryptedData, key, additionalData)
+}
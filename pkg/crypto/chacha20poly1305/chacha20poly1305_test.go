@@ -0,0 +1,171 @@
+package chacha20poly1305
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestEncryptAndDecrypt(t *testing.T) {
+	key, err := GenKey()
+	if err != nil {
+		t.Fatalf("GenKey failed: %v", err)
+	}
+
+	if len(key) != KeySize {
+		t.Errorf("GenKey length = %d, want %d", len(key), KeySize)
+	}
+
+	additionalData := []byte("header")
+
+	for name, tc := range map[string]struct {
+		encrypt   func([]byte, []byte, []byte) ([]byte, error)
+		decrypt   func([]byte, []byte, []byte) ([]byte, error)
+		nonceSize int
+	}{
+		"ChaCha20-Poly1305":  {Encrypt, Decrypt, NonceSize},
+		"XChaCha20-Poly1305": {EncryptX, DecryptX, NonceSizeX},
+	} {
+		encrypted, err := tc.encrypt([]byte(content), key, additionalData)
+		if err != nil {
+			t.Fatalf("%s encrypt failed: %v", name, err)
+		}
+
+		if len(encrypted) != tc.nonceSize+len(content)+Overhead {
+			t.Errorf("%s ciphertext length = %d, want %d", name, len(encrypted), tc.nonceSize+len(content)+Overhead)
+		}
+
+		decrypted, err := tc.decrypt(encrypted, key, additionalData)
+		if err != nil {
+			t.Fatalf("%s decrypt failed: %v", name, err)
+		}
+
+		if string(decrypted) != content {
+			t.Errorf("Decrypted content does not match original: %s", decrypted)
+		}
+
+		// 附加数据不一致时解密失败
+		if _, err := tc.decrypt(encrypted, key, nil); err == nil {
+			t.Errorf("%s decrypt with wrong additional data should fail", name)
+		}
+
+		// 篡改密文后解密失败
+		encrypted[len(encrypted)-1] ^= 1
+		if _, err := tc.decrypt(encrypted, key, additionalData); err == nil {
+			t.Errorf("%s decrypt with tampered ciphertext should fail", name)
+		}
+	}
+}
+
+func TestEncryptAndDecryptBase64(t *testing.T) {
+	key, err := GenKey()
+	if err != nil {
+		t.Fatalf("GenKey failed: %v", err)
+	}
+
+	// 测试普通数据和空数据
+	for _, data := range [][]byte{[]byte(content), {}} {
+		encrypted, err := EncryptBase64(data, key, nil)
+		if err != nil {
+			t.Fatalf("EncryptBase64 failed: %v", err)
+		}
+
+		decrypted, err := DecryptFromBase64(encrypted, key, nil)
+		if err != nil {
+			t.Fatalf("DecryptFromBase64 failed: %v", err)
+		}
+
+		if !bytes.Equal(decrypted, data) {
+			t.Errorf("Decrypted content does not match original: %s", decrypted)
+		}
+
+		encrypted, err = EncryptXBase64(data, key, nil)
+		if err != nil {
+			t.Fatalf("EncryptXBase64 failed: %v", err)
+		}
+
+		decrypted, err = DecryptXFromBase64(encrypted, key, nil)
+		if err != nil {
+			t.Fatalf("DecryptXFromBase64 failed: %v", err)
+		}
+
+		if !bytes.Equal(decrypted, data) {
+			t.Errorf("Decrypted content does not match original: %s", decrypted)
+		}
+
+		// 两种算法的密文不能互相解密
+		if _, err := DecryptFromBase64(encrypted, key, nil); err == nil {
+			t.Error("DecryptFromBase64 should reject XChaCha20-Poly1305 ciphertext")
+		}
+	}
+}
+
+func TestDecryptRfc8439(t *testing.T) {
+	// RFC 8439 第2.8.2节测试向量
+	key := mustDecodeHex("808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f")
+	additionalData := mustDecodeHex("50515253c0c1c2c3c4c5c6c7")
+	encrypted := mustDecodeHex("070000004041424344454647" +
+		"d31a8d34648e60db7b86afbc53ef7ec2a4aded51296e08fea9e2b5a736ee62d6" +
+		"3dbea45e8ca9671282fafb69da92728b1a71de0a9e060b2905d6a5b67ecd3b36" +
+		"92ddbd7f2d778b8c9803aee328091b58fab324e4fad675945585808b4831d7bc" +
+		"3ff4def08e4b7a9de576d26586cec64b6116" +
+		"1ae10b594f09e26a7e902ecbd0600691")
+
+	decrypted, err := Decrypt(encrypted, key, additionalData)
+	if err != nil {
+		t.Fatalf("Decrypt failed: %v", err)
+	}
+
+	want := "Ladies and Gentlemen of the class of '99: If I could offer you only one tip for the future, sunscreen would be it."
+	if string(decrypted) != want {
+		t.Errorf("Decrypt = %q, want %q", decrypted, want)
+	}
+}
+
+func TestDecryptXDraftXchacha(t *testing.T) {
+	// draft-irtf-cfrg-xchacha-03 附录A.3.1测试向量
+	key := mustDecodeHex("808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f")
+	additionalData := mustDecodeHex("50515253c0c1c2c3c4c5c6c7")
+	encrypted := mustDecodeHex("404142434445464748494a4b4c4d4e4f5051525354555657" +
+		"bd6d179d3e83d43b9576579493c0e939572a1700252bfaccbed2902c21396cbb" +
+		"731c7f1b0b4aa6440bf3a82f4eda7e39ae64c6708c54c216cb96b72e1213b452" +
+		"2f8c9ba40db5d945b11b69b982c1bb9e3f3fac2bc369488f76b2383565d3fff9" +
+		"21f9664c97637da9768812f615c68b13b52e" +
+		"c0875924c1c7987947deafd8780acf49")
+
+	decrypted, err := DecryptX(encrypted, key, additionalData)
+	if err != nil {
+		t.Fatalf("DecryptX failed: %v", err)
+	}
+
+	want := "Ladies and Gentlemen of the class of '99: If I could offer you only one tip for the future, sunscreen would be it."
+	if string(decrypted) != want {
+		t.Errorf("DecryptX = %q, want %q", decrypted, want)
+	}
+
+	// 篡改附加数据后认证失败
+	if _, err := DecryptX(encrypted, key, []byte("tampered")); err == nil {
+		t.Error("DecryptX should reject wrong additional data")
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	if _, err := Encrypt([]byte(content), make([]byte, 16), nil); err == nil {
+		t.Error("Encrypt should reject invalid key length")
+	}
+
+	if _, err := DecryptX(make([]byte, NonceSizeX+Overhead-1), make([]byte, KeySize), nil); err == nil {
+		t.Error("DecryptX should reject short ciphertext")
+	}
+}
+
+const content = "hello chacha"
+
+// mustDecodeHex 是一个辅助函数，用于从十六进制字符串解码数据
+func mustDecodeHex(s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,114 @@
+// Package ecdh provides X25519 and P-256 Diffie-Hellman key agreement with HKDF-SHA256 session key derivation.
+// 私钥支持PKCS8和SEC1格式，公钥支持PKIX和原始格式，均可直接传入PEM文本。
+package ecdh
+
+import (
+	"encoding/base64"
+
+	internalecdh "go-secure-utils/internal/crypto/ecdh"
+)
+
+// DefaultKeyLength is the session key length used when none is given, suitable for AES-256.
+const DefaultKeyLength = 32
+
+// Curve identifies a key agreement curve.
+type Curve = internalecdh.Curve
+
+// Supported curves.
+const (
+	X25519 = internalecdh.X25519
+	P256   = internalecdh.P256
+)
+
+// EcdhKeyPair represents a pair of ECDH keys.
+type EcdhKeyPair struct {
+	PublicKey  []byte
+	PrivateKey []byte
+}
+
+// GetPublicKeyBase64 returns the base64 encoded public key.
+func (kp *EcdhKeyPair) GetPublicKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PublicKey)
+}
+
+// GetPrivateKeyBase64 returns the base64 encoded private key.
+func (kp *EcdhKeyPair) GetPrivateKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PrivateKey)
+}
+
+// ParseCurve parses a curve name such as "X25519", "P-256" or "prime256v1".
+func ParseCurve(name string) (Curve, error) {
+	return internalecdh.ParseCurve(name)
+}
+
+// GenKeyPair generates a new ECDH key pair on the given curve.
+// 私钥为PKCS8格式，公钥为PKIX格式。
+func GenKeyPair(curve Curve) (*EcdhKeyPair, error) {
+	// Default curve if not provided
+	if curve == 0 {
+		curve = X25519
+	}
+
+	keyPair, err := internalecdh.GenKeyPair(curve)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EcdhKeyPair{
+		PublicKey:  keyPair.PublicKey,
+		PrivateKey: keyPair.PrivateKey,
+	}, nil
+}
+
+// ExtractPublicKey extracts the PKIX public key from a private key.
+func ExtractPublicKey(privateKey []byte) ([]byte, error) {
+	return internalecdh.ExtractPublicKey(privateKey)
+}
+
+// PublicKeyToRaw returns the raw public key: 32 bytes for X25519, a 65-byte uncompressed point for P-256.
+func PublicKeyToRaw(publicKey []byte) ([]byte, error) {
+	return internalecdh.PublicKeyToRaw(publicKey)
+}
+
+// RawToPublicKey converts a raw X25519 or uncompressed P-256 public key to PKIX DER.
+func RawToPublicKey(raw []byte) ([]byte, error) {
+	return internalecdh.RawToPublicKey(raw)
+}
+
+// PrivateKeyToPem encodes a private key as PKCS#8 "PRIVATE KEY" PEM.
+func PrivateKeyToPem(privateKey []byte) (string, error) {
+	return internalecdh.PrivateKeyToPem(privateKey)
+}
+
+// PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func PublicKeyToPem(publicKey []byte) (string, error) {
+	return internalecdh.PublicKeyToPem(publicKey)
+}
+
+// SharedSecret computes the raw Diffie-Hellman shared secret between a private key and a peer public key.
+// 共享密钥不应直接用作对称密钥，请使用DeriveSessionKey派生。
+func SharedSecret(privateKey []byte, peerPublicKey []byte) ([]byte, error) {
+	return internalecdh.SharedSecret(privateKey, peerPublicKey)
+}
+
+// DeriveKey derives a symmetric key from a shared secret using HKDF-SHA256.
+// length为0时使用DefaultKeyLength。
+func DeriveKey(sharedSecret []byte, salt []byte, info []byte, length int) ([]byte, error) {
+	// Default length if not provided
+	if length == 0 {
+		length = DefaultKeyLength
+	}
+
+	return internalecdh.DeriveKey(sharedSecret, salt, info, length)
+}
+
+// DeriveSessionKey computes the shared secret with the peer and derives a symmetric key from it with HKDF-SHA256.
+// 双方使用相同的salt和info即可得到相同的会话密钥。
+func DeriveSessionKey(privateKey []byte, peerPublicKey []byte, salt []byte, info []byte, length int) ([]byte, error) {
+	// Default length if not provided
+	if length == 0 {
+		length = DefaultKeyLength
+	}
+
+	return internalecdh.DeriveSessionKey(privateKey, peerPublicKey, salt, info, length)
+}
@@ -0,0 +1,233 @@
+package ecdh
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/x509"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestGenKeyPair(t *testing.T) {
+	for _, curve := range []Curve{X25519, P256} {
+		keyPair, err := GenKeyPair(curve)
+		if err != nil {
+			t.Fatalf("GenKeyPair(%v) failed: %v", curve, err)
+		}
+
+		if _, err := x509.ParsePKCS8PrivateKey(keyPair.PrivateKey); err != nil {
+			t.Errorf("PrivateKey is not PKCS8: %v", err)
+		}
+
+		if _, err := x509.ParsePKIXPublicKey(keyPair.PublicKey); err != nil {
+			t.Errorf("PublicKey is not PKIX: %v", err)
+		}
+
+		extracted, err := ExtractPublicKey(keyPair.PrivateKey)
+		if err != nil {
+			t.Fatalf("ExtractPublicKey failed: %v", err)
+		}
+
+		if !bytes.Equal(extracted, keyPair.PublicKey) {
+			t.Error("Extracted public key does not match generated public key")
+		}
+	}
+
+	if _, err := GenKeyPair(Curve(99)); err == nil {
+		t.Error("GenKeyPair should reject unsupported curve")
+	}
+}
+
+func TestDeriveSessionKey(t *testing.T) {
+	salt := []byte("salt")
+	info := []byte("chat v1")
+
+	for curve, rawSize := range map[Curve]int{X25519: 32, P256: 65} {
+		alice, err := GenKeyPair(curve)
+		if err != nil {
+			t.Fatalf("GenKeyPair(%v) failed: %v", curve, err)
+		}
+		bob, err := GenKeyPair(curve)
+		if err != nil {
+			t.Fatalf("GenKeyPair(%v) failed: %v", curve, err)
+		}
+
+		aliceKey, err := DeriveSessionKey(alice.PrivateKey, bob.PublicKey, salt, info, 0)
+		if err != nil {
+			t.Fatalf("DeriveSessionKey failed: %v", err)
+		}
+
+		if len(aliceKey) != DefaultKeyLength {
+			t.Errorf("Session key length = %d, want %d", len(aliceKey), DefaultKeyLength)
+		}
+
+		// 对端使用原始格式公钥也能得到相同的会话密钥
+		rawAlicePublicKey, err := PublicKeyToRaw(alice.PublicKey)
+		if err != nil {
+			t.Fatalf("PublicKeyToRaw failed: %v", err)
+		}
+
+		if len(rawAlicePublicKey) != rawSize {
+			t.Errorf("PublicKeyToRaw(%v) length = %d, want %d", curve, len(rawAlicePublicKey), rawSize)
+		}
+
+		bobKey, err := DeriveSessionKey(bob.PrivateKey, rawAlicePublicKey, salt, info, 0)
+		if err != nil {
+			t.Fatalf("DeriveSessionKey failed: %v", err)
+		}
+
+		if !bytes.Equal(aliceKey, bobKey) {
+			t.Errorf("Session keys on %v do not match", curve)
+		}
+
+		// 不同的info派生出不同的密钥
+		otherKey, err := DeriveSessionKey(bob.PrivateKey, alice.PublicKey, salt, []byte("file v1"), 0)
+		if err != nil {
+			t.Fatalf("DeriveSessionKey failed: %v", err)
+		}
+
+		if bytes.Equal(aliceKey, otherKey) {
+			t.Error("Session keys with different info should differ")
+		}
+	}
+}
+
+func TestSharedSecretCurveMismatch(t *testing.T) {
+	x25519KeyPair, err := GenKeyPair(X25519)
+	if err != nil {
+		t.Fatalf("GenKeyPair failed: %v", err)
+	}
+	p256KeyPair, err := GenKeyPair(P256)
+	if err != nil {
+		t.Fatalf("GenKeyPair failed: %v", err)
+	}
+
+	if _, err := SharedSecret(x25519KeyPair.PrivateKey, p256KeyPair.PublicKey); err == nil {
+		t.Error("SharedSecret should reject peer key on a different curve")
+	}
+}
+
+func TestSharedSecretRfc7748(t *testing.T) {
+	// RFC 7748 第6.1节测试向量
+	alicePrivateKey := x25519PrivateKey(t, "77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	bobPublicKey := mustDecodeHex("de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f")
+	want := mustDecodeHex("4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742")
+
+	sharedSecret, err := SharedSecret(alicePrivateKey, bobPublicKey)
+	if err != nil {
+		t.Fatalf("SharedSecret failed: %v", err)
+	}
+
+	if !bytes.Equal(sharedSecret, want) {
+		t.Errorf("SharedSecret = %x, want %x", sharedSecret, want)
+	}
+
+	// PEM格式私钥
+	pemData, err := PrivateKeyToPem(alicePrivateKey)
+	if err != nil {
+		t.Fatalf("PrivateKeyToPem failed: %v", err)
+	}
+
+	sharedSecret, err = SharedSecret([]byte(pemData), bobPublicKey)
+	if err != nil || !bytes.Equal(sharedSecret, want) {
+		t.Errorf("SharedSecret with PEM key failed: %v", err)
+	}
+}
+
+func TestDeriveKeyRfc5869(t *testing.T) {
+	// RFC 5869 附录A.1测试向量
+	okm, err := DeriveKey(
+		bytes.Repeat([]byte{0x0b}, 22),
+		mustDecodeHex("000102030405060708090a0b0c"),
+		mustDecodeHex("f0f1f2f3f4f5f6f7f8f9"),
+		42,
+	)
+	if err != nil {
+		t.Fatalf("DeriveKey failed: %v", err)
+	}
+
+	want := "3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865"
+	if hex.EncodeToString(okm) != want {
+		t.Errorf("DeriveKey = %x, want %s", okm, want)
+	}
+
+	if _, err := DeriveKey(okm, nil, nil, -1); err == nil {
+		t.Error("DeriveKey should reject invalid length")
+	}
+}
+
+func TestPublicKeyConversion(t *testing.T) {
+	raw := mustDecodeHex("8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a")
+
+	publicKey, err := RawToPublicKey(raw)
+	if err != nil {
+		t.Fatalf("RawToPublicKey failed: %v", err)
+	}
+
+	pemData, err := PublicKeyToPem(publicKey)
+	if err != nil {
+		t.Fatalf("PublicKeyToPem failed: %v", err)
+	}
+
+	if !strings.HasPrefix(pemData, "-----BEGIN PUBLIC KEY-----") {
+		t.Errorf("Unexpected PEM header: %s", pemData)
+	}
+
+	roundTrip, err := PublicKeyToRaw([]byte(pemData))
+	if err != nil {
+		t.Fatalf("PublicKeyToRaw failed: %v", err)
+	}
+
+	if !bytes.Equal(roundTrip, raw) {
+		t.Error("Raw public key round trip does not match original")
+	}
+
+	if _, err := RawToPublicKey(raw[:31]); err == nil {
+		t.Error("RawToPublicKey should reject invalid length")
+	}
+}
+
+func TestParseCurve(t *testing.T) {
+	for name, want := range map[string]Curve{
+		"X25519":     X25519,
+		"x25519":     X25519,
+		"P-256":      P256,
+		"prime256v1": P256,
+	} {
+		got, err := ParseCurve(name)
+		if err != nil {
+			t.Fatalf("ParseCurve(%q) failed: %v", name, err)
+		}
+		if got != want {
+			t.Errorf("ParseCurve(%q) = %v, want %v", name, got, want)
+		}
+	}
+
+	if _, err := ParseCurve("X448"); err == nil {
+		t.Error("ParseCurve should reject unsupported curve")
+	}
+}
+
+// x25519PrivateKey 将原始X25519私钥转换为PKCS8格式
+func x25519PrivateKey(t *testing.T, rawHex string) []byte {
+	privateKey, err := ecdh.X25519().NewPrivateKey(mustDecodeHex(rawHex))
+	if err != nil {
+		t.Fatalf("NewPrivateKey failed: %v", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey failed: %v", err)
+	}
+	return der
+}
+
+// mustDecodeHex 是一个辅助函数，用于从十六进制字符串解码数据
+func mustDecodeHex(s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,130 @@
+// Package ecdsa provides ECDSA key generation, signing and verification on NIST P-256, P-384 and P-521.
+// 私钥支持PKCS8和SEC1格式，公钥支持PKIX、X.509证书和未压缩点格式，均可直接传入PEM文本。
+package ecdsa
+
+import (
+	"encoding/base64"
+
+	internalecdsa "go-secure-utils/internal/crypto/ecdsa"
+)
+
+// Curve identifies a NIST elliptic curve.
+type Curve = internalecdsa.Curve
+
+// Supported curves.
+const (
+	P256 = internalecdsa.P256
+	P384 = internalecdsa.P384
+	P521 = internalecdsa.P521
+)
+
+// ErrVerification is returned when a signature does not match.
+var ErrVerification = internalecdsa.ErrVerification
+
+// EcdsaKeyPair represents a pair of ECDSA keys.
+type EcdsaKeyPair struct {
+	PublicKey  []byte
+	PrivateKey []byte
+}
+
+// GetPublicKeyBase64 returns the base64 encoded public key.
+func (kp *EcdsaKeyPair) GetPublicKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PublicKey)
+}
+
+// GetPrivateKeyBase64 returns the base64 encoded private key.
+func (kp *EcdsaKeyPair) GetPrivateKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PrivateKey)
+}
+
+// ParseCurve parses a curve name such as "P-256", "secp256r1" or "prime256v1".
+func ParseCurve(name string) (Curve, error) {
+	return internalecdsa.ParseCurve(name)
+}
+
+// GenKeyPair generates a new ECDSA key pair on the given curve.
+// 私钥为PKCS8格式，公钥为PKIX格式。
+func GenKeyPair(curve Curve) (*EcdsaKeyPair, error) {
+	// Default curve if not provided
+	if curve == 0 {
+		curve = P256
+	}
+
+	keyPair, err := internalecdsa.GenKeyPair(curve)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EcdsaKeyPair{
+		PublicKey:  keyPair.PublicKey,
+		PrivateKey: keyPair.PrivateKey,
+	}, nil
+}
+
+// ExtractPublicKey extracts the PKIX public key from a private key.
+func ExtractPublicKey(privateKey []byte) ([]byte, error) {
+	return internalecdsa.ExtractPublicKey(privateKey)
+}
+
+// SignBase64 signs data with private key and returns base64 encoded DER signature.
+func SignBase64(data string, privateKey []byte) (string, error) {
+	return internalecdsa.SignBase64(data, privateKey)
+}
+
+// Sign signs data with private key and returns an ASN.1 DER signature.
+// 摘要算法由曲线决定：P-256使用SHA-256，P-384使用SHA-384，P-521使用SHA-512。
+func Sign(data []byte, privateKey []byte) ([]byte, error) {
+	return internalecdsa.Sign(data, privateKey)
+}
+
+// SignRaw signs data with private key and returns a fixed-length r||s signature.
+func SignRaw(data []byte, privateKey []byte) ([]byte, error) {
+	return internalecdsa.SignRaw(data, privateKey)
+}
+
+// VerifyFromBase64 verifies base64 encoded DER signature with public key.
+func VerifyFromBase64(data string, publicKey []byte, signatureBase64 string) (bool, error) {
+	return internalecdsa.VerifyFromBase64(data, publicKey, signatureBase64)
+}
+
+// Verify verifies an ASN.1 DER signature with public key.
+func Verify(data []byte, publicKey []byte, signature []byte) (bool, error) {
+	return internalecdsa.Verify(data, publicKey, signature)
+}
+
+// VerifyRaw verifies a fixed-length r||s signature with public key.
+func VerifyRaw(data []byte, publicKey []byte, signature []byte) (bool, error) {
+	return internalecdsa.VerifyRaw(data, publicKey, signature)
+}
+
+// DerToRawSignature converts an ASN.1 DER signature to fixed-length r||s for the given curve.
+// 输出长度由曲线决定，curve 必须显式指定。
+func DerToRawSignature(signature []byte, curve Curve) ([]byte, error) {
+	return internalecdsa.DerToRawSignature(signature, curve)
+}
+
+// RawToDerSignature converts a fixed-length r||s signature to ASN.1 DER.
+func RawToDerSignature(signature []byte) ([]byte, error) {
+	return internalecdsa.RawToDerSignature(signature)
+}
+
+// ConvertPkcs8ToSec1 converts a PKCS#8 encoded private key to SEC 1 ECPrivateKey.
+func ConvertPkcs8ToSec1(pkcs8 []byte) ([]byte, error) {
+	return internalecdsa.ConvertPkcs8ToSec1(pkcs8)
+}
+
+// ConvertSec1ToPkcs8 converts a SEC 1 ECPrivateKey to PKCS#8.
+func ConvertSec1ToPkcs8(sec1 []byte) ([]byte, error) {
+	return internalecdsa.ConvertSec1ToPkcs8(sec1)
+}
+
+// PrivateKeyToPem encodes a PKCS#8 or SEC 1 private key as PEM.
+// SEC1输出EC PRIVATE KEY，PKCS8输出PRIVATE KEY。
+func PrivateKeyToPem(privateKey []byte) (string, error) {
+	return internalecdsa.PrivateKeyToPem(privateKey)
+}
+
+// PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func PublicKeyToPem(publicKey []byte) (string, error) {
+	return internalecdsa.PublicKeyToPem(publicKey)
+}
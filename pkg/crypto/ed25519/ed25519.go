@@ -0,0 +1,105 @@
+// Package ed25519 provides deterministic Ed25519 signatures.
+// 私钥支持PKCS8格式和32字节种子，公钥支持PKIX、X.509证书和32字节原始格式，均可直接传入PEM文本。
+package ed25519
+
+import (
+	"encoding/base64"
+
+	internaled25519 "go-secure-utils/internal/crypto/ed25519"
+)
+
+// ErrVerification is returned when a signature does not match.
+var ErrVerification = internaled25519.ErrVerification
+
+// Ed25519KeyPair represents a pair of Ed25519 keys.
+type Ed25519KeyPair struct {
+	PublicKey  []byte
+	PrivateKey []byte
+}
+
+// GetPublicKeyBase64 returns the base64 encoded public key.
+func (kp *Ed25519KeyPair) GetPublicKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PublicKey)
+}
+
+// GetPrivateKeyBase64 returns the base64 encoded private key.
+func (kp *Ed25519KeyPair) GetPrivateKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PrivateKey)
+}
+
+// GenKeyPair generates a new Ed25519 key pair.
+// 私钥为PKCS8格式，公钥为PKIX格式。
+func GenKeyPair() (*Ed25519KeyPair, error) {
+	keyPair, err := internaled25519.GenKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ed25519KeyPair{
+		PublicKey:  keyPair.PublicKey,
+		PrivateKey: keyPair.PrivateKey,
+	}, nil
+}
+
+// GenKeyPairFromSeed derives an Ed25519 key pair from a 32-byte seed.
+func GenKeyPairFromSeed(seed []byte) (*Ed25519KeyPair, error) {
+	keyPair, err := internaled25519.GenKeyPairFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ed25519KeyPair{
+		PublicKey:  keyPair.PublicKey,
+		PrivateKey: keyPair.PrivateKey,
+	}, nil
+}
+
+// ExtractPublicKey extracts the PKIX public key from a private key.
+func ExtractPublicKey(privateKey []byte) ([]byte, error) {
+	return internaled25519.ExtractPublicKey(privateKey)
+}
+
+// PrivateKeyToSeed returns the raw 32-byte seed of a private key.
+func PrivateKeyToSeed(privateKey []byte) ([]byte, error) {
+	return internaled25519.PrivateKeyToSeed(privateKey)
+}
+
+// PublicKeyToRaw returns the raw 32-byte public key.
+func PublicKeyToRaw(publicKey []byte) ([]byte, error) {
+	return internaled25519.PublicKeyToRaw(publicKey)
+}
+
+// RawToPublicKey converts a raw 32-byte public key to PKIX DER.
+func RawToPublicKey(raw []byte) ([]byte, error) {
+	return internaled25519.RawToPublicKey(raw)
+}
+
+// PrivateKeyToPem encodes a private key as PKCS#8 "PRIVATE KEY" PEM.
+func PrivateKeyToPem(privateKey []byte) (string, error) {
+	return internaled25519.PrivateKeyToPem(privateKey)
+}
+
+// PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func PublicKeyToPem(publicKey []byte) (string, error) {
+	return internaled25519.PublicKeyToPem(publicKey)
+}
+
+// SignBase64 signs data with private key and returns base64 encoded signature.
+func SignBase64(data string, privateKey []byte) (string, error) {
+	return internaled25519.SignBase64(data, privateKey)
+}
+
+// Sign signs data with private key and returns a 64-byte signature.
+func Sign(data []byte, privateKey []byte) ([]byte, error) {
+	return internaled25519.Sign(data, privateKey)
+}
+
+// VerifyFromBase64 verifies base64 encoded signature with public key.
+func VerifyFromBase64(data string, publicKey []byte, signatureBase64 string) (bool, error) {
+	return internaled25519.VerifyFromBase64(data, publicKey, signatureBase64)
+}
+
+// Verify verifies signature with public key.
+func Verify(data []byte, publicKey []byte, signature []byte) (bool, error) {
+	return internaled25519.Verify(data, publicKey, signature)
+}
@@ -0,0 +1,31 @@
+// Package envelope provides hybrid encryption for payloads larger than the RSA modulus.
+// 内容使用随机 AES-256-GCM 密钥加密，内容密钥使用 RSA-OAEP(SHA-256) 包装，
+// 输出为带版本号的自描述二进制格式。
+package envelope
+
+import (
+	internalenvelope "go-secure-utils/internal/crypto/envelope"
+)
+
+// Version is the current envelope container version.
+const Version = internalenvelope.Version
+
+// SealBase64 encrypts data for the public key and returns the base64 encoded envelope.
+func SealBase64(data []byte, publicKey []byte) (string, error) {
+	return internalenvelope.SealBase64(data, publicKey)
+}
+
+// Seal encrypts data of any length for the public key.
+func Seal(data []byte, publicKey []byte) ([]byte, error) {
+	return internalenvelope.Seal(data, publicKey)
+}
+
+// OpenFromBase64 decrypts a base64 encoded envelope with private key.
+func OpenFromBase64(sealed string, privateKey []byte) ([]byte, error) {
+	return internalenvelope.OpenFromBase64(sealed, privateKey)
+}
+
+// Open decrypts an envelope produced by Seal with private key.
+func Open(sealed []byte, privateKey []byte) ([]byte, error) {
+	return internalenvelope.Open(sealed, privateKey)
+}
@@ -0,0 +1,107 @@
+package envelope
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"go-secure-utils/pkg/crypto/rsa"
+)
+
+func TestSealAndOpen(t *testing.T) {
+	// 测试远大于RSA模长的数据
+	data := make([]byte, 1<<20)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := Seal(data, keyPair.PublicKey)
+	if err != nil {
+		t.Fatalf("Seal failed: %v", err)
+	}
+
+	if sealed[4] != Version {
+		t.Errorf("Envelope version = %d, want %d", sealed[4], Version)
+	}
+
+	opened, err := Open(sealed, keyPair.PrivateKey)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	if !bytes.Equal(data, opened) {
+		t.Error("Opened content does not match original")
+	}
+}
+
+func TestSealAndOpenBase64(t *testing.T) {
+	// 测试Base64格式和空数据
+	for _, data := range [][]byte{[]byte("hello envelope"), {}} {
+		sealed, err := SealBase64(data, keyPair.PublicKey)
+		if err != nil {
+			t.Fatalf("SealBase64 failed: %v", err)
+		}
+
+		opened, err := OpenFromBase64(sealed, keyPair.PrivateKey)
+		if err != nil {
+			t.Fatalf("OpenFromBase64 failed: %v", err)
+		}
+
+		if !bytes.Equal(data, opened) {
+			t.Errorf("Opened content does not match original: got %s, want %s", opened, data)
+		}
+	}
+}
+
+func TestOpenTamperedShouldFail(t *testing.T) {
+	sealed, err := Seal([]byte("hello envelope"), keyPair.PublicKey)
+	if err != nil {
+		t.Fatalf("Seal failed: %v", err)
+	}
+
+	// 篡改密文
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := Open(tampered, keyPair.PrivateKey); err == nil {
+		t.Error("Open tampered ciphertext should fail but it succeeded")
+	}
+
+	// 篡改版本号
+	tampered = bytes.Clone(sealed)
+	tampered[4] = Version + 1
+	if _, err := Open(tampered, keyPair.PrivateKey); err == nil {
+		t.Error("Open unsupported version should fail but it succeeded")
+	}
+
+	// 截断数据
+	if _, err := Open(sealed[:10], keyPair.PrivateKey); err == nil {
+		t.Error("Open truncated envelope should fail but it succeeded")
+	}
+}
+
+func TestOpenWithWrongKeyShouldFail(t *testing.T) {
+	sealed, err := Seal([]byte("hello envelope"), keyPair.PublicKey)
+	if err != nil {
+		t.Fatalf("Seal failed: %v", err)
+	}
+
+	otherKeyPair, err := rsa.GenKeyPair(2048)
+	if err != nil {
+		t.Fatalf("GenKeyPair failed: %v", err)
+	}
+
+	if _, err := Open(sealed, otherKeyPair.PrivateKey); err == nil {
+		t.Error("Open with wrong key should fail but it succeeded")
+	}
+}
+
+var keyPair = mustGenKeyPair(2048)
+
+// mustGenKeyPair 生成测试用的密钥对
+func mustGenKeyPair(keySize int) *rsa.RsaKeyPair {
+	kp, err := rsa.GenKeyPair(keySize)
+	if err != nil {
+		panic(err)
+	}
+	return kp
+}
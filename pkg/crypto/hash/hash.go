@@ -0,0 +1,94 @@
+// Package hash provides message digests (MD5, SHA-1, SHA-2, SHA-3, BLAKE2) and HMAC, one-shot or incremental.
+// 算法为0时使用默认值SHA-256。
+package hash
+
+import (
+	internalhash "go-secure-utils/internal/crypto/hash"
+)
+
+// Algorithm identifies a hash algorithm.
+type Algorithm = internalhash.Algorithm
+
+// Supported hash algorithms.
+const (
+	MD5         = internalhash.MD5
+	SHA1        = internalhash.SHA1
+	SHA224      = internalhash.SHA224
+	SHA256      = internalhash.SHA256
+	SHA384      = internalhash.SHA384
+	SHA512      = internalhash.SHA512
+	SHA512_224  = internalhash.SHA512_224
+	SHA512_256  = internalhash.SHA512_256
+	SHA3_224    = internalhash.SHA3_224
+	SHA3_256    = internalhash.SHA3_256
+	SHA3_384    = internalhash.SHA3_384
+	SHA3_512    = internalhash.SHA3_512
+	BLAKE2b_256 = internalhash.BLAKE2b_256
+	BLAKE2b_384 = internalhash.BLAKE2b_384
+	BLAKE2b_512 = internalhash.BLAKE2b_512
+	BLAKE2s_256 = internalhash.BLAKE2s_256
+)
+
+// DefaultAlgorithm is the hash algorithm used when none is given.
+const DefaultAlgorithm = SHA256
+
+// Hasher computes a digest or HMAC incrementally.
+type Hasher = internalhash.Hasher
+
+// ParseAlgorithm parses a hash algorithm name such as "SHA-256", "sha3-512" or "BLAKE2b-256".
+func ParseAlgorithm(name string) (Algorithm, error) {
+	return internalhash.ParseAlgorithm(name)
+}
+
+// Sum returns the digest of data.
+func Sum(data []byte, algorithm Algorithm) ([]byte, error) {
+	return internalhash.Sum(data, withDefault(algorithm))
+}
+
+// SumHex returns the hex encoded digest of data.
+func SumHex(data []byte, algorithm Algorithm) (string, error) {
+	return internalhash.SumHex(data, withDefault(algorithm))
+}
+
+// SumBase64 returns the base64 encoded digest of data.
+func SumBase64(data []byte, algorithm Algorithm) (string, error) {
+	return internalhash.SumBase64(data, withDefault(algorithm))
+}
+
+// Hmac returns the HMAC of data with key.
+func Hmac(data []byte, key []byte, algorithm Algorithm) ([]byte, error) {
+	return internalhash.Hmac(data, key, withDefault(algorithm))
+}
+
+// HmacHex returns the hex encoded HMAC of data with key.
+func HmacHex(data []byte, key []byte, algorithm Algorithm) (string, error) {
+	return internalhash.HmacHex(data, key, withDefault(algorithm))
+}
+
+// HmacBase64 returns the base64 encoded HMAC of data with key.
+func HmacBase64(data []byte, key []byte, algorithm Algorithm) (string, error) {
+	return internalhash.HmacBase64(data, key, withDefault(algorithm))
+}
+
+// VerifyHmac reports whether mac is the HMAC of data with key, using constant-time comparison.
+func VerifyHmac(data []byte, key []byte, mac []byte, algorithm Algorithm) (bool, error) {
+	return internalhash.VerifyHmac(data, key, mac, withDefault(algorithm))
+}
+
+// New creates a Hasher computing the digest with the given algorithm.
+func New(algorithm Algorithm) (*Hasher, error) {
+	return internalhash.New(withDefault(algorithm))
+}
+
+// NewHmac creates a Hasher computing the HMAC with key and the given algorithm.
+func NewHmac(key []byte, algorithm Algorithm) (*Hasher, error) {
+	return internalhash.NewHmac(key, withDefault(algorithm))
+}
+
+// 算法为0时使用默认值
+func withDefault(algorithm Algorithm) Algorithm {
+	if algorithm == 0 {
+		return DefaultAlgorithm
+	}
+	return algorithm
+}
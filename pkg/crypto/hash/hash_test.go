@@ -0,0 +1,173 @@
+package hash
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	// FIPS 180-4、FIPS 202、RFC 1321和RFC 7693中"abc"的摘要
+	for algorithm, want := range map[Algorithm]string{
+		MD5:         "900150983cd24fb0d6963f7d28e17f72",
+		SHA1:        "a9993e364706816aba3e25717850c26c9cd0d89d",
+		SHA224:      "23097d223405d8228642a477bda255b32aadbce4bda0b3f7e36c9da7",
+		SHA256:      "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		SHA384:      "cb00753f45a35e8bb5a03d699ac65007272c32ab0eded1631a8b605a43ff5bed8086072ba1e7cc2358baeca134c825a7",
+		SHA512:      "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f",
+		SHA512_224:  "4634270f707b6a54daae7530460842e20e37ed265ceee9a43e8924aa",
+		SHA512_256:  "53048e2681941ef99b2e29b76b4c7dabe4c2d0c634fc6d46e0e2f13107e7af23",
+		SHA3_224:    "e642824c3f8cf24ad09234ee7d3c766fc9a3a5168d0c94ad73b46fdf",
+		SHA3_256:    "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532",
+		SHA3_384:    "ec01498288516fc926459f58e2c6ad8df9b473cb0fc08c2596da7cf0e49be4b298d88cea927ac7f539f1edf228376d25",
+		SHA3_512:    "b751850b1a57168a5693cd924b6b096e08f621827444f70d884f5d0240d2712e10e116e9192af3c91a7ec57647e3934057340b4cf408d5a56592f8274eec53f0",
+		BLAKE2b_256: "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319",
+		BLAKE2b_384: "6f56a82c8e7ef526dfe182eb5212f7db9df1317e57815dbda46083fc30f54ee6c66ba83be64b302d7cba6ce15bb556f4",
+		BLAKE2b_512: "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923",
+		BLAKE2s_256: "508c5e8c327c14e2e1a72ba34eeb452f37458b209ed63a294d999b4c86675982",
+	} {
+		got, err := SumHex([]byte("abc"), algorithm)
+		if err != nil {
+			t.Fatalf("SumHex(%v) failed: %v", algorithm, err)
+		}
+		if got != want {
+			t.Errorf("SumHex(%v) = %s, want %s", algorithm, got, want)
+		}
+
+		if size := algorithm.Size(); size != len(want)/2 {
+			t.Errorf("%v.Size() = %d, want %d", algorithm, size, len(want)/2)
+		}
+	}
+
+	// 算法为0时使用SHA-256
+	got, err := SumBase64([]byte("abc"), 0)
+	if err != nil {
+		t.Fatalf("SumBase64 failed: %v", err)
+	}
+	if got != "ungWv48Bz+pBQUDeXa4iI7ADYaOWF3qctBD/YfIAFa0=" {
+		t.Errorf("SumBase64 = %s", got)
+	}
+
+	if _, err := Sum([]byte("abc"), Algorithm(99)); err == nil {
+		t.Error("Sum should reject unsupported algorithm")
+	}
+}
+
+func TestHmac(t *testing.T) {
+	// RFC 4231 测试用例2，其余算法与Python hmac模块结果一致
+	key := []byte("Jefe")
+	data := []byte("what do ya want for nothing?")
+	for algorithm, want := range map[Algorithm]string{
+		MD5:         "750c783e6ab0b503eaa86e310a5db738",
+		SHA256:      "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		SHA512:      "164b7a7bfcf819e2e395fbe73b56e0a387bd64222e831fd610270cd7ea2505549758bf75c05a994a6d034f65f8f0e6fdcaeab1a34d4a6b4b636e070a38bce737",
+		SHA3_256:    "c7d4072e788877ae3596bbb0da73b887c9171f93095b294ae857fbe2645e1ba5",
+		BLAKE2b_256: "3cf096eeeb2202a250db168c4823a44ef4618ebabb225789386fed316131e3a0",
+	} {
+		got, err := HmacHex(data, key, algorithm)
+		if err != nil {
+			t.Fatalf("HmacHex(%v) failed: %v", algorithm, err)
+		}
+		if got != want {
+			t.Errorf("HmacHex(%v) = %s, want %s", algorithm, got, want)
+		}
+
+		verified, err := VerifyHmac(data, key, mustDecodeHex(want), algorithm)
+		if err != nil || !verified {
+			t.Errorf("VerifyHmac(%v) failed: %v", algorithm, err)
+		}
+
+		verified, err = VerifyHmac([]byte("wrong content"), key, mustDecodeHex(want), algorithm)
+		if err != nil || verified {
+			t.Errorf("VerifyHmac(%v) with wrong content should fail", algorithm)
+		}
+	}
+}
+
+func TestHasher(t *testing.T) {
+	data := []byte(strings.Repeat("streaming data ", 1000))
+
+	for _, algorithm := range []Algorithm{SHA256, SHA3_512, BLAKE2b_256, MD5} {
+		want, err := Sum(data, algorithm)
+		if err != nil {
+			t.Fatalf("Sum(%v) failed: %v", algorithm, err)
+		}
+
+		hasher, err := New(algorithm)
+		if err != nil {
+			t.Fatalf("New(%v) failed: %v", algorithm, err)
+		}
+
+		// 分块写入
+		for chunk := range slices.Chunk(data, 7) {
+			hasher.Update(chunk)
+		}
+		if got := hasher.Final(); !bytes.Equal(got, want) {
+			t.Errorf("Hasher(%v) = %x, want %x", algorithm, got, want)
+		}
+
+		// 重置后通过io.Copy写入
+		hasher.Reset()
+		if _, err := io.Copy(hasher, bytes.NewReader(data)); err != nil {
+			t.Fatalf("io.Copy failed: %v", err)
+		}
+		if got := hasher.FinalHex(); got != hex.EncodeToString(want) {
+			t.Errorf("Hasher(%v) after Reset = %s, want %x", algorithm, got, want)
+		}
+	}
+
+	key := []byte("secret")
+	want, err := HmacBase64(data, key, 0)
+	if err != nil {
+		t.Fatalf("HmacBase64 failed: %v", err)
+	}
+
+	hasher, err := NewHmac(key, 0)
+	if err != nil {
+		t.Fatalf("NewHmac failed: %v", err)
+	}
+	hasher.Update(data[:100])
+	hasher.Update(data[100:])
+	if got := hasher.FinalBase64(); got != want {
+		t.Errorf("HMAC Hasher = %s, want %s", got, want)
+	}
+	if hasher.Algorithm() != SHA256 || hasher.Size() != 32 {
+		t.Errorf("HMAC Hasher algorithm = %v, size = %d", hasher.Algorithm(), hasher.Size())
+	}
+}
+
+func TestParseAlgorithm(t *testing.T) {
+	for name, want := range map[string]Algorithm{
+		"SHA-256":     SHA256,
+		"sha256":      SHA256,
+		"SHA3-512":    SHA3_512,
+		"sha512/256":  SHA512_256,
+		"BLAKE2b-256": BLAKE2b_256,
+		"blake2s":     BLAKE2s_256,
+		"md5":         MD5,
+	} {
+		got, err := ParseAlgorithm(name)
+		if err != nil {
+			t.Fatalf("ParseAlgorithm(%q) failed: %v", name, err)
+		}
+		if got != want {
+			t.Errorf("ParseAlgorithm(%q) = %v, want %v", name, got, want)
+		}
+	}
+
+	if _, err := ParseAlgorithm("whirlpool"); err == nil {
+		t.Error("ParseAlgorithm should reject unsupported algorithm")
+	}
+}
+
+// mustDecodeHex 是一个辅助函数，用于从十六进制字符串解码数据
+func mustDecodeHex(s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,87 @@
+// Package kdf provides password-based and secret-based key derivation: PBKDF2, scrypt, Argon2id and HKDF,
+// plus Argon2id password hashing in PHC string format.
+// 选项为nil或字段为0时使用OWASP推荐的默认参数，PBKDF2和HKDF的摘要算法使用hash包的Algorithm。
+package kdf
+
+import (
+	internalhash "go-secure-utils/internal/crypto/hash"
+	internalkdf "go-secure-utils/internal/crypto/kdf"
+)
+
+// Default parameters used when an option is zero.
+const (
+	DefaultKeyLength        = internalkdf.DefaultKeyLength
+	DefaultPbkdf2Iterations = internalkdf.DefaultPbkdf2Iterations
+	DefaultScryptN          = internalkdf.DefaultScryptN
+	DefaultScryptR          = internalkdf.DefaultScryptR
+	DefaultScryptP          = internalkdf.DefaultScryptP
+	DefaultArgon2Time       = internalkdf.DefaultArgon2Time
+	DefaultArgon2Memory     = internalkdf.DefaultArgon2Memory
+	DefaultArgon2Threads    = internalkdf.DefaultArgon2Threads
+)
+
+// PasswordSaltSize is the size of the random salt used by HashPassword.
+const PasswordSaltSize = internalkdf.PasswordSaltSize
+
+// Limits on the parameters accepted by HashPassword and VerifyPassword.
+const (
+	MaxPasswordTime      = internalkdf.MaxPasswordTime
+	MaxPasswordMemory    = internalkdf.MaxPasswordMemory
+	MaxPasswordThreads   = internalkdf.MaxPasswordThreads
+	MinPasswordSaltSize  = internalkdf.MinPasswordSaltSize
+	MaxPasswordSaltSize  = internalkdf.MaxPasswordSaltSize
+	MinPasswordKeyLength = internalkdf.MinPasswordKeyLength
+	MaxPasswordKeyLength = internalkdf.MaxPasswordKeyLength
+)
+
+// Limits on the scrypt parameters accepted by Scrypt.
+const (
+	MaxScryptN      = internalkdf.MaxScryptN
+	MaxScryptMemory = internalkdf.MaxScryptMemory
+)
+
+// ErrPasswordMismatch is returned when a password does not match its hash.
+var ErrPasswordMismatch = internalkdf.ErrPasswordMismatch
+
+// Pbkdf2Options configures Pbkdf2.
+type Pbkdf2Options = internalkdf.Pbkdf2Options
+
+// ScryptOptions configures Scrypt.
+type ScryptOptions = internalkdf.ScryptOptions
+
+// Argon2Options configures Argon2id and HashPassword.
+type Argon2Options = internalkdf.Argon2Options
+
+// Pbkdf2 derives a key from password and salt with PBKDF2 (RFC 8018).
+func Pbkdf2(password []byte, salt []byte, opts *Pbkdf2Options) ([]byte, error) {
+	return internalkdf.Pbkdf2(password, salt, opts)
+}
+
+// Scrypt derives a key from password and salt with scrypt (RFC 7914).
+// N 超过 MaxScryptN 或 128·N·r·p 超过 MaxScryptMemory 字节时返回错误。
+func Scrypt(password []byte, salt []byte, opts *ScryptOptions) ([]byte, error) {
+	return internalkdf.Scrypt(password, salt, opts)
+}
+
+// Argon2id derives a key from password and salt with Argon2id (RFC 9106).
+func Argon2id(password []byte, salt []byte, opts *Argon2Options) ([]byte, error) {
+	return internalkdf.Argon2id(password, salt, opts)
+}
+
+// Hkdf derives a key of the given length from secret with HKDF (RFC 5869).
+// algorithm 与 go-secure-utils/pkg/crypto/hash 的 Algorithm 相同。
+// length为0时使用32字节，algorithm为0时使用SHA-256。
+func Hkdf(secret []byte, salt []byte, info []byte, length int, algorithm internalhash.Algorithm) ([]byte, error) {
+	return internalkdf.Hkdf(secret, salt, info, length, algorithm)
+}
+
+// HashPassword hashes password with Argon2id and a random salt, returning a PHC string.
+func HashPassword(password string, opts *Argon2Options) (string, error) {
+	return internalkdf.HashPassword(password, opts)
+}
+
+// VerifyPassword verifies password against an Argon2id PHC string.
+// 参数超出 MaxPassword* 等限制的字符串在派生前即被拒绝。
+func VerifyPassword(password string, encodedHash string) (bool, error) {
+	return internalkdf.VerifyPassword(password, encodedHash)
+}
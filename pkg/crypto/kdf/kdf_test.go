@@ -0,0 +1,196 @@
+package kdf
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"go-secure-utils/pkg/crypto/hash"
+)
+
+func TestPbkdf2(t *testing.T) {
+	for _, tc := range []struct {
+		password, salt string
+		opts           *Pbkdf2Options
+		want           string
+	}{
+		// RFC 7914 第11节
+		{"passwd", "salt", &Pbkdf2Options{Iterations: 1, KeyLength: 64},
+			"55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783"},
+		// RFC 6070 测试用例2
+		{"password", "salt", &Pbkdf2Options{Hash: hash.SHA1, Iterations: 2, KeyLength: 20},
+			"ea6c014dc72d6f8ccd1ed92ace1d41f0d8de8957"},
+		// 与Python hashlib.pbkdf2_hmac结果一致
+		{"password", "salt", &Pbkdf2Options{Hash: hash.SHA512, Iterations: 1000, KeyLength: 64},
+			"afe6c5530785b6cc6b1c6453384731bd5ee432ee549fd42fb6695779ad8a1c5bf59de69c48f774efc4007d5298f9033c0241d5ab69305e7b64eceeb8d834cfec"},
+	} {
+		key, err := Pbkdf2([]byte(tc.password), []byte(tc.salt), tc.opts)
+		if err != nil {
+			t.Fatalf("Pbkdf2(%v) failed: %v", tc.opts.Hash, err)
+		}
+		if got := hex.EncodeToString(key); got != tc.want {
+			t.Errorf("Pbkdf2(%v) = %s, want %s", tc.opts.Hash, got, tc.want)
+		}
+	}
+
+	// 摘要算法与 hash 包一致，0 表示 SHA-256
+	defaultKey, err := Pbkdf2([]byte("password"), []byte("salt"), &Pbkdf2Options{Iterations: 1000})
+	if err != nil {
+		t.Fatalf("Pbkdf2 failed: %v", err)
+	}
+	sha256Key, err := Pbkdf2([]byte("password"), []byte("salt"), &Pbkdf2Options{Hash: hash.SHA256, Iterations: 1000})
+	if err != nil {
+		t.Fatalf("Pbkdf2(SHA-256) failed: %v", err)
+	}
+	if !bytes.Equal(defaultKey, sha256Key) {
+		t.Error("Pbkdf2 with zero hash should use SHA-256")
+	}
+
+	if _, err := Pbkdf2([]byte("password"), []byte("salt"), &Pbkdf2Options{Iterations: -1}); err == nil {
+		t.Error("Pbkdf2 should reject negative iterations")
+	}
+}
+
+func TestScrypt(t *testing.T) {
+	// RFC 7914 第12节
+	key, err := Scrypt([]byte("password"), []byte("NaCl"), &ScryptOptions{N: 1024, R: 8, P: 16, KeyLength: 64})
+	if err != nil {
+		t.Fatalf("Scrypt failed: %v", err)
+	}
+
+	want := "fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b3731622eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640"
+	if got := hex.EncodeToString(key); got != want {
+		t.Errorf("Scrypt = %s, want %s", got, want)
+	}
+
+	if _, err := Scrypt([]byte("password"), []byte("NaCl"), &ScryptOptions{N: 1000}); err == nil {
+		t.Error("Scrypt should reject N that is not a power of two")
+	}
+
+	// 超出内存限制的参数返回错误而不是 panic
+	for _, opts := range []*ScryptOptions{
+		{N: 1 << 30, R: 1 << 20, P: 1},
+		{N: MaxScryptN * 2},
+		{N: MaxScryptN, R: 16},
+		{N: 1 << 14, R: 8, P: 1 << 30},
+		{N: 1 << 14, R: -1},
+	} {
+		if _, err := Scrypt([]byte("password"), []byte("NaCl"), opts); err == nil {
+			t.Errorf("Scrypt(%+v) should reject parameters above limit", *opts)
+		}
+	}
+}
+
+func TestArgon2id(t *testing.T) {
+	// Argon2参考实现 test.c 中的Argon2id测试向量
+	key, err := Argon2id([]byte("password"), []byte("somesalt"), &Argon2Options{Time: 2, Memory: 65536, Threads: 1})
+	if err != nil {
+		t.Fatalf("Argon2id failed: %v", err)
+	}
+
+	want := "09316115d5cf24ed5a15a31a3ba326e5cf32edc24702987c02b6566f61913cf7"
+	if got := hex.EncodeToString(key); got != want {
+		t.Errorf("Argon2id = %s, want %s", got, want)
+	}
+
+	if _, err := Argon2id([]byte("password"), []byte("somesalt"), &Argon2Options{Threads: 256}); err == nil {
+		t.Error("Argon2id should reject more than 255 threads")
+	}
+}
+
+func TestHkdf(t *testing.T) {
+	// RFC 5869 测试用例1
+	key, err := Hkdf(
+		bytes.Repeat([]byte{0x0b}, 22),
+		mustDecodeHex("000102030405060708090a0b0c"),
+		mustDecodeHex("f0f1f2f3f4f5f6f7f8f9"),
+		42, 0)
+	if err != nil {
+		t.Fatalf("Hkdf failed: %v", err)
+	}
+
+	want := "3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865"
+	if got := hex.EncodeToString(key); got != want {
+		t.Errorf("Hkdf = %s, want %s", got, want)
+	}
+
+	if _, err := Hkdf([]byte("secret"), nil, nil, 255*32+1, hash.SHA256); err == nil {
+		t.Error("Hkdf should reject too long key length")
+	}
+}
+
+func TestHashAndVerifyPassword(t *testing.T) {
+	opts := &Argon2Options{Time: 1, Memory: 64}
+	encoded, err := HashPassword("correct horse", opts)
+	if err != nil {
+		t.Fatalf("HashPassword failed: %v", err)
+	}
+
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Errorf("Unexpected PHC string: %s", encoded)
+	}
+
+	verified, err := VerifyPassword("correct horse", encoded)
+	if err != nil || !verified {
+		t.Errorf("VerifyPassword failed: %v", err)
+	}
+
+	verified, err = VerifyPassword("wrong horse", encoded)
+	if verified || !errors.Is(err, ErrPasswordMismatch) {
+		t.Errorf("VerifyPassword with wrong password = %v, %v", verified, err)
+	}
+
+	// 相同口令每次使用不同的盐
+	other, err := HashPassword("correct horse", opts)
+	if err != nil {
+		t.Fatalf("HashPassword failed: %v", err)
+	}
+	if other == encoded {
+		t.Error("HashPassword should use a random salt")
+	}
+
+	// 生成的字符串必须能通过 VerifyPassword 的参数限制
+	if _, err := HashPassword("correct horse", &Argon2Options{Time: MaxPasswordTime + 1, Memory: 64}); err == nil {
+		t.Error("HashPassword should reject time above limit")
+	}
+	if _, err := HashPassword("correct horse", &Argon2Options{Time: 1, Memory: 64, KeyLength: 8}); err == nil {
+		t.Error("HashPassword should reject too short key length")
+	}
+}
+
+func TestVerifyReferencePhcString(t *testing.T) {
+	// Argon2参考实现生成的编码字符串
+	verified, err := VerifyPassword("password", "$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc")
+	if err != nil || !verified {
+		t.Errorf("VerifyPassword failed: %v", err)
+	}
+
+	for _, encoded := range []string{
+		"$argon2i$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+		"$argon2id$v=16$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+		"$argon2id$v=19$m=65536,t=2$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+		"$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ",
+		"not a hash",
+		// 超出限制的参数在派生前被拒绝
+		"$argon2id$v=19$m=4294967295,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+		"$argon2id$v=19$m=65536,t=4294967295,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+		"$argon2id$v=19$m=65536,t=2,p=255$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+		"$argon2id$v=19$m=65536,t=2,p=1$c2FsdA$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc",
+		"$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO0",
+	} {
+		if _, err := VerifyPassword("password", encoded); err == nil || errors.Is(err, ErrPasswordMismatch) {
+			t.Errorf("VerifyPassword(%q) should reject malformed string, got %v", encoded, err)
+		}
+	}
+}
+
+// mustDecodeHex 是一个辅助函数，用于从十六进制字符串解码数据
+func mustDecodeHex(s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
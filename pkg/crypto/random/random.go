@@ -0,0 +1,41 @@
+// Package random provides cryptographically secure random bytes, integers, URL-safe tokens and UUIDs.
+// 所有函数均基于crypto/rand，各平台使用同一随机数来源。
+package random
+
+import (
+	internalrandom "go-secure-utils/internal/crypto/random"
+)
+
+// DefaultTokenLength is the number of random bytes used by Token when none is given.
+const DefaultTokenLength = 32
+
+// Bytes returns n cryptographically secure random bytes.
+func Bytes(n int) ([]byte, error) {
+	return internalrandom.Bytes(n)
+}
+
+// Int returns a uniformly distributed random integer in the closed range [min, max].
+func Int(min int64, max int64) (int64, error) {
+	return internalrandom.Int(min, max)
+}
+
+// Token returns a URL-safe base64 encoding of n random bytes without padding.
+func Token(n int) (string, error) {
+	// Default length if not provided
+	if n == 0 {
+		n = DefaultTokenLength
+	}
+
+	return internalrandom.Token(n)
+}
+
+// UuidV4 returns a random (version 4) UUID in canonical form.
+func UuidV4() (string, error) {
+	return internalrandom.UuidV4()
+}
+
+// UuidV7 returns a time-ordered (version 7) UUID in canonical form.
+// 同一进程内生成的 UUID 严格递增，同一毫秒内也按生成顺序排序。
+func UuidV7() (string, error) {
+	return internalrandom.UuidV7()
+}
@@ -0,0 +1,129 @@
+package random
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBytes(t *testing.T) {
+	a, err := Bytes(32)
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	b, err := Bytes(32)
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+
+	if len(a) != 32 || bytes.Equal(a, b) {
+		t.Errorf("Bytes returned %x and %x", a, b)
+	}
+
+	if empty, err := Bytes(0); err != nil || len(empty) != 0 {
+		t.Errorf("Bytes(0) = %x, %v", empty, err)
+	}
+
+	if _, err := Bytes(-1); err == nil {
+		t.Error("Bytes should reject negative length")
+	}
+}
+
+func TestInt(t *testing.T) {
+	seen := make(map[int64]bool)
+	for i := 0; i < 1000; i++ {
+		n, err := Int(-3, 3)
+		if err != nil {
+			t.Fatalf("Int failed: %v", err)
+		}
+		if n < -3 || n > 3 {
+			t.Fatalf("Int(-3, 3) = %d out of range", n)
+		}
+		seen[n] = true
+	}
+
+	// 1000次抽样应覆盖区间内全部7个值
+	if len(seen) != 7 {
+		t.Errorf("Int(-3, 3) produced only %d distinct values", len(seen))
+	}
+
+	if n, err := Int(5, 5); err != nil || n != 5 {
+		t.Errorf("Int(5, 5) = %d, %v", n, err)
+	}
+
+	// 完整int64区间不应溢出
+	if _, err := Int(math.MinInt64, math.MaxInt64); err != nil {
+		t.Errorf("Int over full range failed: %v", err)
+	}
+
+	if _, err := Int(2, 1); err == nil {
+		t.Error("Int should reject min greater than max")
+	}
+}
+
+func TestToken(t *testing.T) {
+	token, err := Token(0)
+	if err != nil {
+		t.Fatalf("Token failed: %v", err)
+	}
+
+	if len(token) != 43 {
+		t.Errorf("Token length = %d, want 43", len(token))
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(decoded) != DefaultTokenLength {
+		t.Errorf("Token is not URL-safe base64 of %d bytes: %s", DefaultTokenLength, token)
+	}
+
+	if token, err = Token(16); err != nil || len(token) != 22 {
+		t.Errorf("Token(16) = %s, %v", token, err)
+	}
+}
+
+func TestUuid(t *testing.T) {
+	v4 := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	v7 := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	uuid, err := UuidV4()
+	if err != nil {
+		t.Fatalf("UuidV4 failed: %v", err)
+	}
+	if !v4.MatchString(uuid) {
+		t.Errorf("UuidV4 = %s", uuid)
+	}
+
+	before := time.Now().UnixMilli()
+	uuid, err = UuidV7()
+	if err != nil {
+		t.Fatalf("UuidV7 failed: %v", err)
+	}
+	after := time.Now().UnixMilli()
+
+	if !v7.MatchString(uuid) {
+		t.Errorf("UuidV7 = %s", uuid)
+	}
+
+	// 前48位为毫秒时间戳
+	ts, err := strconv.ParseInt(uuid[0:8]+uuid[9:13], 16, 64)
+	if err != nil || ts < before || ts > after {
+		t.Errorf("UuidV7 timestamp = %d, want between %d and %d", ts, before, after)
+	}
+
+	// 同一毫秒内生成的 UUID 也按生成顺序递增
+	prev := uuid
+	for i := 0; i < 1000; i++ {
+		next, err := UuidV7()
+		if err != nil {
+			t.Fatalf("UuidV7 failed: %v", err)
+		}
+		if next <= prev {
+			t.Fatalf("UuidV7 is not monotonic: %s after %s", next, prev)
+		}
+		prev = next
+	}
+}
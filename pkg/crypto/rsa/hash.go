@@ -0,0 +1,23 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// Hash identifies a hash algorithm used by RSA padding schemes.
+type Hash = internalrsa.Hash
+
+// Supported hash algorithms.
+const (
+	SHA1     = internalrsa.SHA1
+	SHA224   = internalrsa.SHA224
+	SHA256   = internalrsa.SHA256
+	SHA384   = internalrsa.SHA384
+	SHA512   = internalrsa.SHA512
+	SHA3_256 = internalrsa.SHA3_256
+)
+
+// ParseHash parses a hash algorithm name such as "SHA-256", "sha256" or "SHA3-256".
+func ParseHash(name string) (Hash, error) {
+	return internalrsa.ParseHash(name)
+}
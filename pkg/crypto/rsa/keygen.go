@@ -0,0 +1,66 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// PrivateKeyFormat identifies the encoding format of an RSA private key.
+type PrivateKeyFormat = internalrsa.PrivateKeyFormat
+
+// Supported private key formats.
+const (
+	PrivateKeyFormatPkcs1 = internalrsa.PrivateKeyFormatPkcs1
+	PrivateKeyFormatPkcs8 = internalrsa.PrivateKeyFormatPkcs8
+)
+
+// PublicKeyFormat identifies the encoding format of an RSA public key.
+type PublicKeyFormat = internalrsa.PublicKeyFormat
+
+// Supported public key formats.
+const (
+	PublicKeyFormatPkix  = internalrsa.PublicKeyFormatPkix
+	PublicKeyFormatPkcs1 = internalrsa.PublicKeyFormatPkcs1
+)
+
+// KeyEncoding identifies how generated key bytes are encoded.
+type KeyEncoding = internalrsa.KeyEncoding
+
+// Supported key encodings.
+const (
+	KeyEncodingDer    = internalrsa.KeyEncodingDer
+	KeyEncodingPem    = internalrsa.KeyEncodingPem
+	KeyEncodingBase64 = internalrsa.KeyEncodingBase64
+)
+
+// KeyPairOptions configures GenKeyPairWithOptions.
+// 为nil时与GenKeyPair(2048)一致：PKCS1私钥、PKIX公钥、DER编码、公钥指数65537。
+type KeyPairOptions = internalrsa.KeyPairOptions
+
+// ParsePrivateKeyFormat parses a private key format name such as "PKCS1" or "PKCS8".
+func ParsePrivateKeyFormat(name string) (PrivateKeyFormat, error) {
+	return internalrsa.ParsePrivateKeyFormat(name)
+}
+
+// ParsePublicKeyFormat parses a public key format name such as "PKIX" or "PKCS1".
+func ParsePublicKeyFormat(name string) (PublicKeyFormat, error) {
+	return internalrsa.ParsePublicKeyFormat(name)
+}
+
+// ParseKeyEncoding parses a key encoding name such as "DER", "PEM" or "Base64".
+func ParseKeyEncoding(name string) (KeyEncoding, error) {
+	return internalrsa.ParseKeyEncoding(name)
+}
+
+// GenKeyPairWithOptions generates a new RSA key pair with the given formats, encoding and public exponent.
+// PEM和Base64编码时，密钥字节为对应的文本内容。
+func GenKeyPairWithOptions(opts *KeyPairOptions) (*RsaKeyPair, error) {
+	keyPair, err := internalrsa.GenKeyPairWithOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RsaKeyPair{
+		PublicKey:  keyPair.PublicKey,
+		PrivateKey: keyPair.PrivateKey,
+	}, nil
+}
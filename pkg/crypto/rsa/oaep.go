@@ -0,0 +1,29 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// OaepOptions configures RSA-OAEP encryption and decryption.
+// 为nil时摘要算法和MGF1均为SHA-256，标签为空。
+type OaepOptions = internalrsa.OaepOptions
+
+// EncryptOaepBase64 encrypts data with public key using RSA-OAEP and returns base64 encoded result.
+func EncryptOaepBase64(data []byte, publicKey []byte, opts *OaepOptions) (string, error) {
+	return internalrsa.EncryptOaepBase64(data, publicKey, opts)
+}
+
+// EncryptOaep encrypts data with public key using RSA-OAEP.
+func EncryptOaep(data []byte, publicKey []byte, opts *OaepOptions) ([]byte, error) {
+	return internalrsa.EncryptOaep(data, publicKey, opts)
+}
+
+// DecryptOaepFromBase64 decrypts base64 encoded data with private key using RSA-OAEP.
+func DecryptOaepFromBase64(encrypted string, privateKey []byte, opts *OaepOptions) ([]byte, error) {
+	return internalrsa.DecryptOaepFromBase64(encrypted, privateKey, opts)
+}
+
+// DecryptOaep decrypts data with private key using RSA-OAEP.
+func DecryptOaep(encryptedData []byte, privateKey []byte, opts *OaepOptions) ([]byte, error) {
+	return internalrsa.DecryptOaep(encryptedData, privateKey, opts)
+}
@@ -0,0 +1,23 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// PrivateKeyToPem encodes a PKCS#1 or PKCS#8 private key as PEM.
+// PKCS1 输出 RSA PRIVATE KEY，PKCS8 输出 PRIVATE KEY。
+func PrivateKeyToPem(privateKey []byte) (string, error) {
+	return internalrsa.PrivateKeyToPem(privateKey)
+}
+
+// PublicKeyToPem encodes a PKIX or PKCS#1 public key as PEM.
+// PKIX 输出 PUBLIC KEY，PKCS1 输出 RSA PUBLIC KEY。
+func PublicKeyToPem(publicKey []byte) (string, error) {
+	return internalrsa.PublicKeyToPem(publicKey)
+}
+
+// PemToDer decodes the first PEM block and returns its DER bytes.
+// 所有接收公钥或私钥字节的函数也可直接传入PEM文本。
+func PemToDer(pemData string) ([]byte, error) {
+	return internalrsa.PemToDer(pemData)
+}
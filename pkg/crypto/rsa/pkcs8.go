@@ -0,0 +1,40 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// KeyDerivation identifies the password-based key derivation function.
+type KeyDerivation = internalrsa.KeyDerivation
+
+// Supported key derivation functions.
+const (
+	KeyDerivationPbkdf2 = internalrsa.KeyDerivationPbkdf2
+	KeyDerivationScrypt = internalrsa.KeyDerivationScrypt
+)
+
+// EncryptPrivateKeyOptions configures EncryptPrivateKey.
+// 为nil时使用PBKDF2-HMAC-SHA256（100000次迭代）和AES-256-CBC。
+type EncryptPrivateKeyOptions = internalrsa.EncryptPrivateKeyOptions
+
+// ParseKeyDerivation parses a key derivation function name such as "PBKDF2" or "scrypt".
+func ParseKeyDerivation(name string) (KeyDerivation, error) {
+	return internalrsa.ParseKeyDerivation(name)
+}
+
+// EncryptPrivateKey encrypts a private key with password as PKCS#8 EncryptedPrivateKeyInfo DER.
+func EncryptPrivateKey(privateKey []byte, password []byte, opts *EncryptPrivateKeyOptions) ([]byte, error) {
+	return internalrsa.EncryptPrivateKey(privateKey, password, opts)
+}
+
+// EncryptPrivateKeyToPem encrypts a private key with password as "ENCRYPTED PRIVATE KEY" PEM.
+func EncryptPrivateKeyToPem(privateKey []byte, password []byte, opts *EncryptPrivateKeyOptions) (string, error) {
+	return internalrsa.EncryptPrivateKeyToPem(privateKey, password, opts)
+}
+
+// DecryptPrivateKey decrypts a PKCS#8 EncryptedPrivateKeyInfo (DER or PEM) with password and returns PKCS#8 DER.
+// 支持OpenSSL生成的PBES2密钥（PBKDF2或scrypt，AES-128/192/256-CBC）。
+// 迭代次数超过1000万或scrypt参数超出kdf.MaxScryptN、kdf.MaxScryptMemory限制的密钥会被拒绝。
+func DecryptPrivateKey(encryptedKey []byte, password []byte) ([]byte, error) {
+	return internalrsa.DecryptPrivateKey(encryptedKey, password)
+}
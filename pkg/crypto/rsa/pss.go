@@ -0,0 +1,32 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// PssSaltLengthAuto lets signing use the maximum salt length and verification detect it.
+const PssSaltLengthAuto = internalrsa.PssSaltLengthAuto
+
+// PssOptions configures RSASSA-PSS signing and verification.
+// 为nil时使用SHA-256，盐长度等于摘要长度（即PS256）。
+type PssOptions = internalrsa.PssOptions
+
+// SignPssBase64 signs data with private key using RSASSA-PSS and returns base64 encoded signature.
+func SignPssBase64(data string, privateKey []byte, opts *PssOptions) (string, error) {
+	return internalrsa.SignPssBase64(data, privateKey, opts)
+}
+
+// SignPss signs data with private key using RSASSA-PSS.
+func SignPss(data []byte, privateKey []byte, opts *PssOptions) ([]byte, error) {
+	return internalrsa.SignPss(data, privateKey, opts)
+}
+
+// VerifyPssFromBase64 verifies base64 encoded RSASSA-PSS signature with public key.
+func VerifyPssFromBase64(data string, publicKey []byte, signature string, opts *PssOptions) (bool, error) {
+	return internalrsa.VerifyPssFromBase64(data, publicKey, signature, opts)
+}
+
+// VerifyPss verifies RSASSA-PSS signature with public key.
+func VerifyPss(data []byte, publicKey []byte, signature []byte, opts *PssOptions) (bool, error) {
+	return internalrsa.VerifyPss(data, publicKey, signature, opts)
+}
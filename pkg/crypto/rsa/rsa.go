@@ -102,3 +102,23 @@ func Verify(data []byte, publicKey []byte, signature []byte) (bool, error) {
 func VerifySha1(data []byte, publicKey []byte, signature []byte) (bool, error) {
 	return internalrsa.VerifySha1(data, publicKey, signature)
 }
+
+// ConvertPkcs8ToPkcs1 converts PKCS#8 encoded private key to PKCS#1.
+func ConvertPkcs8ToPkcs1(pkcs8 []byte) ([]byte, error) {
+	return internalrsa.ConvertPkcs8ToPkcs1(pkcs8)
+}
+
+// ConvertPkcs1ToPkcs8 converts PKCS#1 encoded private key to PKCS#8.
+func ConvertPkcs1ToPkcs8(pkcs1 []byte) ([]byte, error) {
+	return internalrsa.ConvertPkcs1ToPkcs8(pkcs1)
+}
+
+// ConvertPublicKeyToPkcs1 converts a PKIX, PKCS#1 or certificate encoded public key to PKCS#1 RSAPublicKey.
+func ConvertPublicKeyToPkcs1(publicKey []byte) ([]byte, error) {
+	return internalrsa.ConvertPublicKeyToPkcs1(publicKey)
+}
+
+// ConvertPublicKeyToPkix converts a PKIX, PKCS#1 or certificate encoded public key to PKIX SubjectPublicKeyInfo.
+func ConvertPublicKeyToPkix(publicKey []byte) ([]byte, error) {
+	return internalrsa.ConvertPublicKeyToPkix(publicKey)
+}
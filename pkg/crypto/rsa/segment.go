@@ -0,0 +1,46 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// EncryptSegmentedBase64 encrypts data of any length block by block with PKCS#1 v1.5 and returns base64 encoded result.
+// 兼容Java/Android的RSA/ECB/PKCS1Padding分段加密，每块明文最长keySize/8-11字节。
+func EncryptSegmentedBase64(data []byte, publicKey []byte) (string, error) {
+	return internalrsa.EncryptSegmentedBase64(data, publicKey)
+}
+
+// EncryptSegmented encrypts data of any length block by block with PKCS#1 v1.5.
+func EncryptSegmented(data []byte, publicKey []byte) ([]byte, error) {
+	return internalrsa.EncryptSegmented(data, publicKey)
+}
+
+// DecryptSegmentedFromBase64 decrypts base64 encoded block-wise PKCS#1 v1.5 ciphertext with private key.
+func DecryptSegmentedFromBase64(encrypted string, privateKey []byte) ([]byte, error) {
+	return internalrsa.DecryptSegmentedFromBase64(encrypted, privateKey)
+}
+
+// DecryptSegmented decrypts block-wise PKCS#1 v1.5 ciphertext with private key.
+func DecryptSegmented(encryptedData []byte, privateKey []byte) ([]byte, error) {
+	return internalrsa.DecryptSegmented(encryptedData, privateKey)
+}
+
+// EncryptOaepSegmentedBase64 encrypts data of any length block by block with RSA-OAEP and returns base64 encoded result.
+func EncryptOaepSegmentedBase64(data []byte, publicKey []byte, opts *OaepOptions) (string, error) {
+	return internalrsa.EncryptOaepSegmentedBase64(data, publicKey, opts)
+}
+
+// EncryptOaepSegmented encrypts data of any length block by block with RSA-OAEP.
+func EncryptOaepSegmented(data []byte, publicKey []byte, opts *OaepOptions) ([]byte, error) {
+	return internalrsa.EncryptOaepSegmented(data, publicKey, opts)
+}
+
+// DecryptOaepSegmentedFromBase64 decrypts base64 encoded block-wise RSA-OAEP ciphertext with private key.
+func DecryptOaepSegmentedFromBase64(encrypted string, privateKey []byte, opts *OaepOptions) ([]byte, error) {
+	return internalrsa.DecryptOaepSegmentedFromBase64(encrypted, privateKey, opts)
+}
+
+// DecryptOaepSegmented decrypts block-wise RSA-OAEP ciphertext with private key.
+func DecryptOaepSegmented(encryptedData []byte, privateKey []byte, opts *OaepOptions) ([]byte, error) {
+	return internalrsa.DecryptOaepSegmented(encryptedData, privateKey, opts)
+}
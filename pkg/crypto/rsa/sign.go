@@ -0,0 +1,44 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// Padding identifies an RSA signature padding scheme.
+type Padding = internalrsa.Padding
+
+// Supported signature padding schemes.
+const (
+	PaddingPkcs1v15 = internalrsa.PaddingPkcs1v15
+	PaddingPss      = internalrsa.PaddingPss
+)
+
+// SignOptions configures SignWithOptions and VerifyWithOptions.
+// 为nil时使用SHA-256和PKCS#1 v1.5填充，与Sign/Verify一致。
+type SignOptions = internalrsa.SignOptions
+
+// ParsePadding parses a padding scheme name such as "PKCS1v15" or "PSS".
+func ParsePadding(name string) (Padding, error) {
+	return internalrsa.ParsePadding(name)
+}
+
+// SignWithOptions signs data with private key using the given hash and padding scheme.
+func SignWithOptions(data []byte, privateKey []byte, opts *SignOptions) ([]byte, error) {
+	return internalrsa.SignWithOptions(data, privateKey, opts)
+}
+
+// VerifyWithOptions verifies signature with public key using the given hash and padding scheme.
+func VerifyWithOptions(data []byte, publicKey []byte, signature []byte, opts *SignOptions) (bool, error) {
+	return internalrsa.VerifyWithOptions(data, publicKey, signature, opts)
+}
+
+// SignDigest signs an already-computed digest with private key.
+// opts.Hash 指定摘要所用的算法，digest 长度必须与之匹配。
+func SignDigest(digest []byte, privateKey []byte, opts *SignOptions) ([]byte, error) {
+	return internalrsa.SignDigest(digest, privateKey, opts)
+}
+
+// VerifyDigest verifies signature of an already-computed digest with public key.
+func VerifyDigest(digest []byte, publicKey []byte, signature []byte, opts *SignOptions) (bool, error) {
+	return internalrsa.VerifyDigest(digest, publicKey, signature, opts)
+}
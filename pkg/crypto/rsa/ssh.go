@@ -0,0 +1,32 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// PublicKeyToOpenSsh converts a public key to an OpenSSH authorized_keys line such as "ssh-rsa AAAA... comment".
+func PublicKeyToOpenSsh(publicKey []byte, comment string) (string, error) {
+	return internalrsa.PublicKeyToOpenSsh(publicKey, comment)
+}
+
+// OpenSshToPublicKey parses an OpenSSH authorized_keys line and returns the public key as PKIX DER.
+func OpenSshToPublicKey(authorizedKey string) ([]byte, error) {
+	return internalrsa.OpenSshToPublicKey(authorizedKey)
+}
+
+// PrivateKeyToOpenSsh encodes a private key as "OPENSSH PRIVATE KEY" PEM.
+// passphrase非空时私钥使用口令加密（bcrypt + AES-256-CTR）。
+func PrivateKeyToOpenSsh(privateKey []byte, passphrase []byte, comment string) (string, error) {
+	return internalrsa.PrivateKeyToOpenSsh(privateKey, passphrase, comment)
+}
+
+// OpenSshToPrivateKey parses an "OPENSSH PRIVATE KEY" PEM and returns the private key as PKCS#1 DER.
+func OpenSshToPrivateKey(pemData string, passphrase []byte) ([]byte, error) {
+	return internalrsa.OpenSshToPrivateKey(pemData, passphrase)
+}
+
+// OpenSshFingerprint returns the "SHA256:..." fingerprint of a public key as printed by "ssh-keygen -l".
+// 公钥可以是DER、PEM或authorized_keys格式。
+func OpenSshFingerprint(publicKey []byte) (string, error) {
+	return internalrsa.OpenSshFingerprint(publicKey)
+}
@@ -0,0 +1,22 @@
+package rsa
+
+import (
+	internalrsa "go-secure-utils/internal/crypto/rsa"
+)
+
+// Signer signs data written in chunks, so large inputs never need to be held in memory at once.
+type Signer = internalrsa.Signer
+
+// Verifier verifies a signature over data written in chunks.
+type Verifier = internalrsa.Verifier
+
+// NewSigner creates a Signer for the given private key, hash and padding scheme.
+// opts为nil时使用SHA-256和PKCS#1 v1.5填充，与Sign一致。
+func NewSigner(privateKey []byte, opts *SignOptions) (*Signer, error) {
+	return internalrsa.NewSigner(privateKey, opts)
+}
+
+// NewVerifier creates a Verifier for the given public key, hash and padding scheme.
+func NewVerifier(publicKey []byte, opts *SignOptions) (*Verifier, error) {
+	return internalrsa.NewVerifier(publicKey, opts)
+}
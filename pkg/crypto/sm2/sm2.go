@@ -0,0 +1,118 @@
+// Package sm2 provides SM2 key generation, signing and public key encryption (GB/T 32918).
+// 私钥支持PKCS8、SEC1和32字节原始格式，公钥支持PKIX、X.509证书和65字节未压缩点，均可直接传入PEM文本。
+package sm2
+
+import (
+	"encoding/base64"
+
+	internalsm2 "go-secure-utils/internal/crypto/sm2"
+)
+
+// DefaultUid is the default signer ID defined by GB/T 35276.
+const DefaultUid = internalsm2.DefaultUid
+
+// ErrVerification is returned when a signature does not match.
+var ErrVerification = internalsm2.ErrVerification
+
+// CiphertextOrder selects how the ciphertext components are concatenated.
+type CiphertextOrder = internalsm2.CiphertextOrder
+
+// Supported ciphertext orders.
+const (
+	C1C3C2 = internalsm2.C1C3C2
+	C1C2C3 = internalsm2.C1C2C3
+)
+
+// Sm2KeyPair represents a pair of SM2 keys.
+type Sm2KeyPair struct {
+	PublicKey  []byte
+	PrivateKey []byte
+}
+
+// GetPublicKeyBase64 returns the base64 encoded public key.
+func (kp *Sm2KeyPair) GetPublicKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PublicKey)
+}
+
+// GetPrivateKeyBase64 returns the base64 encoded private key.
+func (kp *Sm2KeyPair) GetPrivateKeyBase64() string {
+	return base64.StdEncoding.EncodeToString(kp.PrivateKey)
+}
+
+// ParseCiphertextOrder parses "C1C3C2" or "C1C2C3".
+func ParseCiphertextOrder(name string) (CiphertextOrder, error) {
+	return internalsm2.ParseCiphertextOrder(name)
+}
+
+// GenKeyPair generates a new SM2 key pair.
+// 私钥为PKCS8格式，公钥为PKIX格式。
+func GenKeyPair() (*Sm2KeyPair, error) {
+	keyPair, err := internalsm2.GenKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sm2KeyPair{
+		PublicKey:  keyPair.PublicKey,
+		PrivateKey: keyPair.PrivateKey,
+	}, nil
+}
+
+// ExtractPublicKey extracts the PKIX public key from a private key.
+func ExtractPublicKey(privateKey []byte) ([]byte, error) {
+	return internalsm2.ExtractPublicKey(privateKey)
+}
+
+// PrivateKeyToPem encodes a private key as PKCS#8 "PRIVATE KEY" PEM.
+func PrivateKeyToPem(privateKey []byte) (string, error) {
+	return internalsm2.PrivateKeyToPem(privateKey)
+}
+
+// PublicKeyToPem encodes a public key as PKIX "PUBLIC KEY" PEM.
+func PublicKeyToPem(publicKey []byte) (string, error) {
+	return internalsm2.PublicKeyToPem(publicKey)
+}
+
+// SignBase64 signs data with private key and returns base64 encoded DER signature.
+func SignBase64(data string, privateKey []byte, uid []byte) (string, error) {
+	return internalsm2.SignBase64(data, privateKey, uid)
+}
+
+// Sign signs data with private key and returns an ASN.1 DER signature.
+// uid为空时使用DefaultUid。
+func Sign(data []byte, privateKey []byte, uid []byte) ([]byte, error) {
+	return internalsm2.Sign(data, privateKey, uid)
+}
+
+// VerifyFromBase64 verifies base64 encoded DER signature with public key.
+func VerifyFromBase64(data string, publicKey []byte, signatureBase64 string, uid []byte) (bool, error) {
+	return internalsm2.VerifyFromBase64(data, publicKey, signatureBase64, uid)
+}
+
+// Verify verifies an ASN.1 DER signature with public key.
+// uid必须与签名时一致，为空时使用DefaultUid。
+func Verify(data []byte, publicKey []byte, signature []byte, uid []byte) (bool, error) {
+	return internalsm2.Verify(data, publicKey, signature, uid)
+}
+
+// EncryptBase64 encrypts data with public key and returns the base64 encoded ciphertext.
+func EncryptBase64(data []byte, publicKey []byte, order CiphertextOrder) (string, error) {
+	return internalsm2.EncryptBase64(data, publicKey, order)
+}
+
+// Encrypt encrypts data with public key.
+// 输出为04开头的非ASN.1密文，C2与C3按order拼接。
+func Encrypt(data []byte, publicKey []byte, order CiphertextOrder) ([]byte, error) {
+	return internalsm2.Encrypt(data, publicKey, order)
+}
+
+// DecryptFromBase64 decrypts base64 encoded ciphertext with private key.
+func DecryptFromBase64(encrypted string, privateKey []byte, order CiphertextOrder) ([]byte, error) {
+	return internalsm2.DecryptFromBase64(encrypted, privateKey, order)
+}
+
+// Decrypt decrypts ciphertext with private key.
+// 同时支持OpenSSL输出的ASN.1格式密文。
+func Decrypt(encryptedData []byte, privateKey []byte, order CiphertextOrder) ([]byte, error) {
+	return internalsm2.Decrypt(encryptedData, privateKey, order)
+}
@@ -0,0 +1,34 @@
+// Package sm3 provides the SM3 cryptographic hash (GB/T 32905) and HMAC-SM3.
+package sm3
+
+import (
+	internalsm3 "go-secure-utils/internal/crypto/sm3"
+)
+
+// Size is the size of an SM3 digest in bytes.
+const Size = internalsm3.Size
+
+// Sum returns the SM3 digest of data.
+func Sum(data []byte) []byte {
+	return internalsm3.Sum(data)
+}
+
+// SumHex returns the hex encoded SM3 digest of data.
+func SumHex(data []byte) string {
+	return internalsm3.SumHex(data)
+}
+
+// Hmac returns the HMAC-SM3 of data with key.
+func Hmac(data []byte, key []byte) []byte {
+	return internalsm3.Hmac(data, key)
+}
+
+// HmacHex returns the hex encoded HMAC-SM3 of data with key.
+func HmacHex(data []byte, key []byte) string {
+	return internalsm3.HmacHex(data, key)
+}
+
+// VerifyHmac reports whether mac is the HMAC-SM3 of data with key, using constant-time comparison.
+func VerifyHmac(data []byte, key []byte, mac []byte) bool {
+	return internalsm3.VerifyHmac(data, key, mac)
+}
@@ -0,0 +1,44 @@
+package sm3
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	// GB/T 32905-2016 附录A示例
+	for data, want := range map[string]string{
+		"abc":                      "66c7f0f462eeedd9d1f2d46bdc10e4e24167c4875cf2f7a2297da02b8f4ba8e0",
+		strings.Repeat("abcd", 16): "debe9ff92275b8a138604889c18e5a4d6fdb70e5387e5765293dcba39c0c5732",
+	} {
+		if got := SumHex([]byte(data)); got != want {
+			t.Errorf("SumHex(%q) = %s, want %s", data, got, want)
+		}
+
+		if got := Sum([]byte(data)); len(got) != Size || hex.EncodeToString(got) != want {
+			t.Errorf("Sum(%q) = %x, want %s", data, got, want)
+		}
+	}
+}
+
+func TestHmac(t *testing.T) {
+	key := []byte("secret")
+
+	mac := Hmac([]byte("hello"), key)
+	if len(mac) != Size {
+		t.Errorf("Hmac length = %d, want %d", len(mac), Size)
+	}
+
+	if HmacHex([]byte("hello"), key) != hex.EncodeToString(mac) {
+		t.Error("HmacHex does not match Hmac")
+	}
+
+	if !VerifyHmac([]byte("hello"), key, mac) {
+		t.Error("VerifyHmac failed")
+	}
+
+	if VerifyHmac([]byte("hello"), []byte("other"), mac) {
+		t.Error("VerifyHmac with wrong key should fail")
+	}
+}
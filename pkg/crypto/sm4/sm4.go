@@ -0,0 +1,122 @@
+// Package sm4 provides SM4 block cipher encryption (GB/T 32907) in ECB, CBC and GCM modes.
+// ECB和CBC模式使用显式传入的IV和可选填充方式，GCM模式使用随机nonce并将其置于密文之前。
+package sm4
+
+import (
+	internalsm4 "go-secure-utils/internal/crypto/sm4"
+)
+
+// Key, block, IV and GCM sizes in bytes.
+const (
+	KeySize      = internalsm4.KeySize
+	BlockSize    = internalsm4.BlockSize
+	IvSize       = internalsm4.IvSize
+	GcmNonceSize = internalsm4.GcmNonceSize
+	GcmTagSize   = internalsm4.GcmTagSize
+)
+
+// Padding selects the block padding scheme used by ECB and CBC modes.
+type Padding = internalsm4.Padding
+
+// Supported padding schemes.
+const (
+	PaddingPkcs7 = internalsm4.PaddingPkcs7
+	PaddingZero  = internalsm4.PaddingZero
+	PaddingNone  = internalsm4.PaddingNone
+)
+
+// ParsePadding parses a padding name such as "PKCS7", "PKCS5Padding", "zero" or "NoPadding".
+func ParsePadding(name string) (Padding, error) {
+	return internalsm4.ParsePadding(name)
+}
+
+// GenKey generates a random 16-byte SM4 key.
+func GenKey() ([]byte, error) {
+	return internalsm4.GenKey()
+}
+
+// GenIv generates a random 16-byte IV for CBC mode.
+func GenIv() ([]byte, error) {
+	return internalsm4.GenIv()
+}
+
+// EncryptEcbBase64 encrypts data with SM4-ECB and returns the base64 encoded ciphertext.
+func EncryptEcbBase64(data []byte, key []byte, padding Padding) (string, error) {
+	return internalsm4.EncryptEcbBase64(data, key, padding)
+}
+
+// EncryptEcbHex encrypts data with SM4-ECB and returns the hex encoded ciphertext.
+func EncryptEcbHex(data []byte, key []byte, padding Padding) (string, error) {
+	return internalsm4.EncryptEcbHex(data, key, padding)
+}
+
+// EncryptEcb encrypts data with SM4-ECB.
+func EncryptEcb(data []byte, key []byte, padding Padding) ([]byte, error) {
+	return internalsm4.EncryptEcb(data, key, padding)
+}
+
+// DecryptEcbFromBase64 decrypts base64 encoded SM4-ECB ciphertext.
+func DecryptEcbFromBase64(encrypted string, key []byte, padding Padding) ([]byte, error) {
+	return internalsm4.DecryptEcbFromBase64(encrypted, key, padding)
+}
+
+// DecryptEcbFromHex decrypts hex encoded SM4-ECB ciphertext.
+func DecryptEcbFromHex(encrypted string, key []byte, padding Padding) ([]byte, error) {
+	return internalsm4.DecryptEcbFromHex(encrypted, key, padding)
+}
+
+// DecryptEcb decrypts SM4-ECB ciphertext.
+func DecryptEcb(encryptedData []byte, key []byte, padding Padding) ([]byte, error) {
+	return internalsm4.DecryptEcb(encryptedData, key, padding)
+}
+
+// EncryptCbcBase64 encrypts data with SM4-CBC and returns the base64 encoded ciphertext.
+func EncryptCbcBase64(data []byte, key []byte, iv []byte, padding Padding) (string, error) {
+	return internalsm4.EncryptCbcBase64(data, key, iv, padding)
+}
+
+// EncryptCbcHex encrypts data with SM4-CBC and returns the hex encoded ciphertext.
+func EncryptCbcHex(data []byte, key []byte, iv []byte, padding Padding) (string, error) {
+	return internalsm4.EncryptCbcHex(data, key, iv, padding)
+}
+
+// EncryptCbc encrypts data with SM4-CBC using an explicit 16-byte IV.
+func EncryptCbc(data []byte, key []byte, iv []byte, padding Padding) ([]byte, error) {
+	return internalsm4.EncryptCbc(data, key, iv, padding)
+}
+
+// DecryptCbcFromBase64 decrypts base64 encoded SM4-CBC ciphertext.
+func DecryptCbcFromBase64(encrypted string, key []byte, iv []byte, padding Padding) ([]byte, error) {
+	return internalsm4.DecryptCbcFromBase64(encrypted, key, iv, padding)
+}
+
+// DecryptCbcFromHex decrypts hex encoded SM4-CBC ciphertext.
+func DecryptCbcFromHex(encrypted string, key []byte, iv []byte, padding Padding) ([]byte, error) {
+	return internalsm4.DecryptCbcFromHex(encrypted, key, iv, padding)
+}
+
+// DecryptCbc decrypts SM4-CBC ciphertext with an explicit 16-byte IV.
+func DecryptCbc(encryptedData []byte, key []byte, iv []byte, padding Padding) ([]byte, error) {
+	return internalsm4.DecryptCbc(encryptedData, key, iv, padding)
+}
+
+// EncryptGcmBase64 encrypts data with SM4-GCM and returns the base64 encoded nonce||ciphertext||tag.
+func EncryptGcmBase64(data []byte, key []byte, additionalData []byte) (string, error) {
+	return internalsm4.EncryptGcmBase64(data, key, additionalData)
+}
+
+// EncryptGcm encrypts data with SM4-GCM (RFC 8998) using a random nonce.
+// 输出格式为nonce(12) || ciphertext || tag(16)，additionalData可为空。
+func EncryptGcm(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return internalsm4.EncryptGcm(data, key, additionalData)
+}
+
+// DecryptGcmFromBase64 decrypts base64 encoded nonce||ciphertext||tag with SM4-GCM.
+func DecryptGcmFromBase64(encrypted string, key []byte, additionalData []byte) ([]byte, error) {
+	return internalsm4.DecryptGcmFromBase64(encrypted, key, additionalData)
+}
+
+// DecryptGcm decrypts nonce||ciphertext||tag produced by EncryptGcm.
+func DecryptGcm(encryptedData []byte, key []byte, additionalData []byte) ([]byte, error) {
+	return internalsm4.DecryptGcm(encryptedData, key, additionalData)
+}
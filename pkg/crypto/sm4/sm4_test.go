@@ -0,0 +1,195 @@
+package sm4
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestEcbKnownAnswer(t *testing.T) {
+	// GB/T 32907-2016 附录A示例1：密钥与明文相同
+	key := mustDecodeHex("0123456789abcdeffedcba9876543210")
+
+	encrypted, err := EncryptEcbHex(key, key, PaddingNone)
+	if err != nil {
+		t.Fatalf("EncryptEcbHex failed: %v", err)
+	}
+
+	if want := "681edf34d206965e86b3e94f536e4246"; encrypted != want {
+		t.Errorf("EncryptEcbHex = %s, want %s", encrypted, want)
+	}
+
+	decrypted, err := DecryptEcbFromHex(encrypted, key, PaddingNone)
+	if err != nil {
+		t.Fatalf("DecryptEcbFromHex failed: %v", err)
+	}
+
+	if !bytes.Equal(decrypted, key) {
+		t.Errorf("DecryptEcbFromHex = %x, want %x", decrypted, key)
+	}
+}
+
+func TestEcbKnownAnswerIterated(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping 1000000 iterations in short mode")
+	}
+
+	// GB/T 32907-2016 附录A示例2：使用同一密钥加密1000000次
+	key := mustDecodeHex("0123456789abcdeffedcba9876543210")
+	block := key
+	for i := 0; i < 1000000; i++ {
+		var err error
+		if block, err = EncryptEcb(block, key, PaddingNone); err != nil {
+			t.Fatalf("EncryptEcb failed: %v", err)
+		}
+	}
+
+	if want := "595298c7c6fd271f0402f804c33d3f66"; hex.EncodeToString(block) != want {
+		t.Errorf("Iterated EncryptEcb = %x, want %s", block, want)
+	}
+}
+
+func TestEncryptAndDecryptEcbAndCbc(t *testing.T) {
+	key, err := GenKey()
+	if err != nil {
+		t.Fatalf("GenKey failed: %v", err)
+	}
+	iv, err := GenIv()
+	if err != nil {
+		t.Fatalf("GenIv failed: %v", err)
+	}
+
+	// 测试空数据、非整块数据和整块数据
+	for _, data := range [][]byte{{}, []byte(content), bytes.Repeat([]byte("a"), 32)} {
+		for _, padding := range []Padding{PaddingPkcs7, PaddingZero} {
+			encrypted, err := EncryptEcbBase64(data, key, padding)
+			if err != nil {
+				t.Fatalf("EncryptEcbBase64(%v) failed: %v", padding, err)
+			}
+
+			decrypted, err := DecryptEcbFromBase64(encrypted, key, padding)
+			if err != nil {
+				t.Fatalf("DecryptEcbFromBase64(%v) failed: %v", padding, err)
+			}
+
+			if !bytes.Equal(decrypted, data) {
+				t.Errorf("ECB decrypted content does not match original: %s", decrypted)
+			}
+
+			encrypted, err = EncryptCbcBase64(data, key, iv, padding)
+			if err != nil {
+				t.Fatalf("EncryptCbcBase64(%v) failed: %v", padding, err)
+			}
+
+			decrypted, err = DecryptCbcFromBase64(encrypted, key, iv, padding)
+			if err != nil {
+				t.Fatalf("DecryptCbcFromBase64(%v) failed: %v", padding, err)
+			}
+
+			if !bytes.Equal(decrypted, data) {
+				t.Errorf("CBC decrypted content does not match original: %s", decrypted)
+			}
+		}
+	}
+
+	// CBC模式下相同的明文分组产生不同的密文分组
+	data := bytes.Repeat([]byte("0123456789abcdef"), 2)
+	encrypted, err := EncryptCbc(data, key, iv, PaddingNone)
+	if err != nil {
+		t.Fatalf("EncryptCbc failed: %v", err)
+	}
+
+	if bytes.Equal(encrypted[:16], encrypted[16:]) {
+		t.Error("CBC should not produce identical ciphertext blocks")
+	}
+
+	if _, err := EncryptCbc(data, key, iv[:8], PaddingPkcs7); err == nil {
+		t.Error("EncryptCbc should reject invalid IV length")
+	}
+
+	if _, err := DecryptCbc(encrypted[:15], key, iv, PaddingNone); err == nil {
+		t.Error("DecryptCbc should reject partial block")
+	}
+}
+
+func TestCbcHex(t *testing.T) {
+	key := mustDecodeHex("0123456789abcdeffedcba9876543210")
+	iv := mustDecodeHex("000102030405060708090a0b0c0d0e0f")
+
+	encrypted, err := EncryptCbcHex([]byte(content), key, iv, PaddingPkcs7)
+	if err != nil {
+		t.Fatalf("EncryptCbcHex failed: %v", err)
+	}
+
+	decrypted, err := DecryptCbcFromHex(strings.ToUpper(encrypted), key, iv, PaddingPkcs7)
+	if err != nil {
+		t.Fatalf("DecryptCbcFromHex failed: %v", err)
+	}
+
+	if string(decrypted) != content {
+		t.Errorf("Decrypted content does not match original: %s", decrypted)
+	}
+}
+
+func TestGcmKnownAnswer(t *testing.T) {
+	// RFC 8998 附录A.1 SM4-GCM测试向量
+	key := mustDecodeHex("0123456789abcdeffedcba9876543210")
+	additionalData := mustDecodeHex("feedfacedeadbeeffeedfacedeadbeefabaddad2")
+	encrypted := mustDecodeHex("00001234567800000000abcd" +
+		"17f399f08c67d5ee19d0dc9969c4bb7d5fd46fd3756489069157b282bb200735" +
+		"d82710ca5c22f0ccfa7cbf93d496ac15a56834cbcf98c397b4024a2691233b8d" +
+		"83de3541e4c2b58177e065a9bf7b62ec")
+
+	decrypted, err := DecryptGcm(encrypted, key, additionalData)
+	if err != nil {
+		t.Fatalf("DecryptGcm failed: %v", err)
+	}
+
+	want := "aaaaaaaaaaaaaaaabbbbbbbbbbbbbbbbccccccccccccccccdddddddddddddddd" +
+		"eeeeeeeeeeeeeeeeffffffffffffffffeeeeeeeeeeeeeeeeaaaaaaaaaaaaaaaa"
+	if hex.EncodeToString(decrypted) != want {
+		t.Errorf("DecryptGcm = %x, want %s", decrypted, want)
+	}
+}
+
+func TestEncryptAndDecryptGcm(t *testing.T) {
+	key, err := GenKey()
+	if err != nil {
+		t.Fatalf("GenKey failed: %v", err)
+	}
+
+	encrypted, err := EncryptGcmBase64([]byte(content), key, []byte("header"))
+	if err != nil {
+		t.Fatalf("EncryptGcmBase64 failed: %v", err)
+	}
+
+	decrypted, err := DecryptGcmFromBase64(encrypted, key, []byte("header"))
+	if err != nil {
+		t.Fatalf("DecryptGcmFromBase64 failed: %v", err)
+	}
+
+	if string(decrypted) != content {
+		t.Errorf("Decrypted content does not match original: %s", decrypted)
+	}
+
+	// 附加数据不一致时解密失败
+	if _, err := DecryptGcmFromBase64(encrypted, key, nil); err == nil {
+		t.Error("DecryptGcmFromBase64 with wrong additional data should fail")
+	}
+
+	if _, err := EncryptGcm([]byte(content), make([]byte, 32), nil); err == nil {
+		t.Error("EncryptGcm should reject invalid key length")
+	}
+}
+
+const content = "hello sm4"
+
+// mustDecodeHex 是一个辅助函数，用于从十六进制字符串解码数据
+func mustDecodeHex(s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,71 @@
+// Package jwk converts RSA keys between DER/PEM and JSON Web Key (RFC 7517) form.
+// 私钥导出为 PKCS#8 DER，公钥导出为 PKIX DER；kid 为空时使用 RFC 7638 指纹。
+package jwk
+
+import (
+	internaljwk "go-secure-utils/internal/jwk"
+)
+
+// KeyTypeRsa is the "kty" value of RSA keys.
+const KeyTypeRsa = internaljwk.KeyTypeRsa
+
+// Key is a JSON Web Key.
+type Key = internaljwk.Key
+
+// KeySet is a JSON Web Key Set.
+type KeySet = internaljwk.KeySet
+
+// NewPublicKey converts a PKIX, PKCS#1 or certificate public key (DER or PEM) to a JWK.
+func NewPublicKey(publicKey []byte, kid string) (*Key, error) {
+	return internaljwk.NewPublicKey(publicKey, kid)
+}
+
+// NewPrivateKey converts a PKCS#1 or PKCS#8 private key (DER or PEM) to a JWK.
+func NewPrivateKey(privateKey []byte, kid string) (*Key, error) {
+	return internaljwk.NewPrivateKey(privateKey, kid)
+}
+
+// ParseKey parses a JSON encoded JWK.
+func ParseKey(data []byte) (*Key, error) {
+	return internaljwk.ParseKey(data)
+}
+
+// ParseKeySet parses a JSON encoded JWKS. 不支持的密钥类型会被跳过。
+func ParseKeySet(data []byte) (*KeySet, error) {
+	return internaljwk.ParseKeySet(data)
+}
+
+// FromPublicKey converts a public key (DER or PEM) to a JSON encoded JWK.
+func FromPublicKey(publicKey []byte, kid string) (string, error) {
+	return internaljwk.FromPublicKey(publicKey, kid)
+}
+
+// FromPrivateKey converts a private key (DER or PEM) to a JSON encoded JWK.
+func FromPrivateKey(privateKey []byte, kid string) (string, error) {
+	return internaljwk.FromPrivateKey(privateKey, kid)
+}
+
+// ToPublicKey converts a JSON encoded JWK (public or private) to a PKIX DER public key.
+func ToPublicKey(jwk string) ([]byte, error) {
+	return internaljwk.ToPublicKey(jwk)
+}
+
+// ToPrivateKey converts a JSON encoded private JWK to a PKCS#8 DER private key.
+func ToPrivateKey(jwk string) ([]byte, error) {
+	return internaljwk.ToPrivateKey(jwk)
+}
+
+// Thumbprint returns the base64url encoded RFC 7638 SHA-256 thumbprint of a JSON encoded JWK.
+func Thumbprint(jwk string) (string, error) {
+	return internaljwk.Thumbprint(jwk)
+}
+
+// AddToSet adds a JSON encoded JWK to a JSON encoded JWKS. jwks为空时新建密钥集，已有密钥和未知成员原样保留。
+func AddToSet(jwks string, jwk string) (string, error) {
+	return internaljwk.AddToSet(jwks, jwk)
+}
+
+// LookupKey returns the JSON encoded JWK with the given kid from a JSON encoded JWKS.
+func LookupKey(jwks string, kid string) (string, error) {
+	return internaljwk.LookupKey(jwks, kid)
+}
@@ -0,0 +1,210 @@
+package jwk
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"go-secure-utils/pkg/crypto/rsa"
+)
+
+func TestPublicKeyRoundTrip(t *testing.T) {
+	jwk, err := FromPublicKey(keyPair.PublicKey, "key-1")
+	if err != nil {
+		t.Fatalf("FromPublicKey failed: %v", err)
+	}
+
+	key, err := ParseKey([]byte(jwk))
+	if err != nil {
+		t.Fatalf("ParseKey failed: %v", err)
+	}
+
+	if key.Kty != KeyTypeRsa || key.Kid != "key-1" || key.E != "AQAB" || key.IsPrivate() {
+		t.Errorf("Unexpected JWK: %s", jwk)
+	}
+
+	publicKey, err := ToPublicKey(jwk)
+	if err != nil {
+		t.Fatalf("ToPublicKey failed: %v", err)
+	}
+
+	if !bytes.Equal(publicKey, keyPair.PublicKey) {
+		t.Error("Converted public key does not match original")
+	}
+
+	if _, err := ToPrivateKey(jwk); err == nil {
+		t.Error("ToPrivateKey should reject public JWK")
+	}
+}
+
+func TestPrivateKeyRoundTrip(t *testing.T) {
+	jwk, err := FromPrivateKey(keyPair.PrivateKey, "")
+	if err != nil {
+		t.Fatalf("FromPrivateKey failed: %v", err)
+	}
+
+	// 检查私钥成员
+	var members map[string]string
+	if err := json.Unmarshal([]byte(jwk), &members); err != nil {
+		t.Fatalf("Unmarshal JWK failed: %v", err)
+	}
+	for _, name := range []string{"kty", "kid", "n", "e", "d", "p", "q", "dp", "dq", "qi"} {
+		if members[name] == "" {
+			t.Errorf("JWK is missing %s", name)
+		}
+	}
+
+	privateKey, err := ToPrivateKey(jwk)
+	if err != nil {
+		t.Fatalf("ToPrivateKey failed: %v", err)
+	}
+
+	expected, err := rsa.ConvertPkcs1ToPkcs8(keyPair.PrivateKey)
+	if err != nil {
+		t.Fatalf("ConvertPkcs1ToPkcs8 failed: %v", err)
+	}
+
+	if !bytes.Equal(privateKey, expected) {
+		t.Error("Converted private key does not match original")
+	}
+
+	// 私钥JWK也可以导出公钥
+	publicKey, err := ToPublicKey(jwk)
+	if err != nil {
+		t.Fatalf("ToPublicKey failed: %v", err)
+	}
+
+	if !bytes.Equal(publicKey, keyPair.PublicKey) {
+		t.Error("Public key from private JWK does not match original")
+	}
+}
+
+func TestThumbprint(t *testing.T) {
+	// RFC 7638 3.1 示例
+	thumbprint, err := Thumbprint(rfc7638Jwk)
+	if err != nil {
+		t.Fatalf("Thumbprint failed: %v", err)
+	}
+
+	if thumbprint != "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs" {
+		t.Errorf("Thumbprint = %s", thumbprint)
+	}
+
+	// kid为空时使用指纹，私钥与公钥指纹一致
+	publicKey, err := NewPublicKey(keyPair.PublicKey, "")
+	if err != nil {
+		t.Fatalf("NewPublicKey failed: %v", err)
+	}
+
+	privateKey, err := NewPrivateKey(keyPair.PrivateKey, "")
+	if err != nil {
+		t.Fatalf("NewPrivateKey failed: %v", err)
+	}
+
+	if publicKey.Kid == "" || publicKey.Kid != privateKey.Kid {
+		t.Errorf("Default kid mismatch: %s != %s", publicKey.Kid, privateKey.Kid)
+	}
+
+	if *privateKey.Public() != *publicKey {
+		t.Error("Public part of private JWK does not match public JWK")
+	}
+}
+
+func TestKeySet(t *testing.T) {
+	jwk1, err := FromPublicKey(keyPair.PublicKey, "key-1")
+	if err != nil {
+		t.Fatalf("FromPublicKey failed: %v", err)
+	}
+
+	jwk2, err := FromPublicKey(otherKeyPair.PublicKey, "key-2")
+	if err != nil {
+		t.Fatalf("FromPublicKey failed: %v", err)
+	}
+
+	jwks, err := AddToSet("", jwk1)
+	if err != nil {
+		t.Fatalf("AddToSet failed: %v", err)
+	}
+
+	if jwks, err = AddToSet(jwks, jwk2); err != nil {
+		t.Fatalf("AddToSet failed: %v", err)
+	}
+
+	if _, err := AddToSet(jwks, jwk1); err == nil {
+		t.Error("AddToSet should reject duplicate kid")
+	}
+
+	found, err := LookupKey(jwks, "key-2")
+	if err != nil {
+		t.Fatalf("LookupKey failed: %v", err)
+	}
+
+	if found != jwk2 {
+		t.Errorf("LookupKey = %s, want %s", found, jwk2)
+	}
+
+	if _, err := LookupKey(jwks, "key-3"); err == nil {
+		t.Error("LookupKey should fail for unknown kid")
+	}
+
+	// 不支持的密钥类型会被跳过
+	set, err := ParseKeySet([]byte(`{"keys":[{"kty":"oct","k":"AAAA"},` + jwk1 + `]}`))
+	if err != nil {
+		t.Fatalf("ParseKeySet failed: %v", err)
+	}
+
+	if len(set.Keys) != 1 || set.Keys[0].Kid != "key-1" {
+		t.Errorf("Unexpected key set: %+v", set.Keys)
+	}
+
+	// 添加密钥时保留不支持的密钥类型
+	jwks, err = AddToSet(`{"keys":[{"kty":"oct","k":"AAAA"}]}`, jwk1)
+	if err != nil {
+		t.Fatalf("AddToSet failed: %v", err)
+	}
+
+	if !strings.Contains(jwks, `{"kty":"oct","k":"AAAA"}`) {
+		t.Errorf("AddToSet dropped existing key: %s", jwks)
+	}
+
+	// 密钥集和新密钥中未知的成员同样保留
+	withX5c := strings.TrimSuffix(jwk2, "}") + `,"x5c":["AAAA"]}`
+	jwks, err = AddToSet(`{"keys":[],"issuer":"example"}`, withX5c)
+	if err != nil {
+		t.Fatalf("AddToSet failed: %v", err)
+	}
+
+	if !strings.Contains(jwks, `"issuer":"example"`) || !strings.Contains(jwks, `"x5c":["AAAA"]`) {
+		t.Errorf("AddToSet dropped unknown members: %s", jwks)
+	}
+}
+
+func TestParseKeyShouldFail(t *testing.T) {
+	for _, data := range []string{
+		`not json`,
+		`{"kty":"EC","crv":"P-256"}`,
+		`{"kty":"RSA","e":"AQAB"}`,
+	} {
+		if _, err := ParseKey([]byte(data)); err == nil {
+			t.Errorf("ParseKey(%s) should fail", data)
+		}
+	}
+}
+
+var (
+	keyPair      = mustGenKeyPair(2048)
+	otherKeyPair = mustGenKeyPair(2048)
+)
+
+// RFC 7638 3.1 示例JWK
+const rfc7638Jwk = `{"kty":"RSA","n":"0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw","e":"AQAB","alg":"RS256","kid":"2011-04-29"}`
+
+// mustGenKeyPair 生成测试用的密钥对
+func mustGenKeyPair(keySize int) *rsa.RsaKeyPair {
+	kp, err := rsa.GenKeyPair(keySize)
+	if err != nil {
+		panic(err)
+	}
+	return kp
+}
@@ -0,0 +1,62 @@
+// Package jwt signs and verifies JSON Web Tokens (RFC 7519) in JWS compact form.
+// 支持 RS256/RS384/RS512、PS256/PS384/PS512、ES256/ES384/ES512 和 EdDSA；不支持 "none" 和 HMAC 算法。
+package jwt
+
+import (
+	internaljwt "go-secure-utils/internal/jwt"
+)
+
+// Algorithm is a JWS "alg" header value.
+type Algorithm = internaljwt.Algorithm
+
+// Supported signature algorithms.
+const (
+	RS256 = internaljwt.RS256
+	RS384 = internaljwt.RS384
+	RS512 = internaljwt.RS512
+	PS256 = internaljwt.PS256
+	PS384 = internaljwt.PS384
+	PS512 = internaljwt.PS512
+	ES256 = internaljwt.ES256
+	ES384 = internaljwt.ES384
+	ES512 = internaljwt.ES512
+	EdDSA = internaljwt.EdDSA
+)
+
+// SignOptions configures Sign.
+type SignOptions = internaljwt.SignOptions
+
+// VerifyOptions configures Verify.
+type VerifyOptions = internaljwt.VerifyOptions
+
+// Verification errors.
+var (
+	ErrVerification        = internaljwt.ErrVerification
+	ErrTokenExpired        = internaljwt.ErrTokenExpired
+	ErrTokenNotValidYet    = internaljwt.ErrTokenNotValidYet
+	ErrTokenIssuedInFuture = internaljwt.ErrTokenIssuedInFuture
+	ErrInvalidIssuer       = internaljwt.ErrInvalidIssuer
+	ErrInvalidAudience     = internaljwt.ErrInvalidAudience
+)
+
+// Sign signs the JSON encoded claims with private key (DER or PEM) and returns the compact token.
+// opts 为nil时按私钥类型选择算法：RSA 为 RS256，EC 按曲线为 ES256/ES384/ES512，Ed25519 为 EdDSA。
+func Sign(claims string, privateKey []byte, opts *SignOptions) (string, error) {
+	return internaljwt.Sign(claims, privateKey, opts)
+}
+
+// Verify verifies the token with public key (DER or PEM), validates exp/nbf/iat/iss/aud
+// and returns the JSON encoded claims.
+func Verify(token string, publicKey []byte, opts *VerifyOptions) (string, error) {
+	return internaljwt.Verify(token, publicKey, opts)
+}
+
+// DecodeHeader returns the JSON encoded header without verifying the token.
+func DecodeHeader(token string) (string, error) {
+	return internaljwt.DecodeHeader(token)
+}
+
+// DecodeClaims returns the JSON encoded claims without verifying the token.
+func DecodeClaims(token string) (string, error) {
+	return internaljwt.DecodeClaims(token)
+}
@@ -0,0 +1,252 @@
+package jwt
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go-secure-utils/pkg/crypto/ecdsa"
+	"go-secure-utils/pkg/crypto/ed25519"
+	"go-secure-utils/pkg/crypto/rsa"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	tests := []struct {
+		name       string
+		privateKey []byte
+		publicKey  []byte
+		opts       *SignOptions
+		alg        Algorithm
+	}{
+		{"RS256 default", rsaKeyPair.PrivateKey, rsaKeyPair.PublicKey, nil, RS256},
+		{"RS384", rsaKeyPair.PrivateKey, rsaKeyPair.PublicKey, &SignOptions{Algorithm: RS384}, RS384},
+		{"RS512", rsaKeyPair.PrivateKey, rsaKeyPair.PublicKey, &SignOptions{Algorithm: RS512}, RS512},
+		{"PS256", rsaKeyPair.PrivateKey, rsaKeyPair.PublicKey, &SignOptions{Algorithm: PS256, Kid: "rsa-1"}, PS256},
+		{"ES256 default", p256KeyPair.PrivateKey, p256KeyPair.PublicKey, nil, ES256},
+		{"ES384 default", p384KeyPair.PrivateKey, p384KeyPair.PublicKey, nil, ES384},
+		{"EdDSA default", ed25519KeyPair.PrivateKey, ed25519KeyPair.PublicKey, nil, EdDSA},
+	}
+
+	claims := `{"sub": "alice", "admin": true}`
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := Sign(claims, tt.privateKey, tt.opts)
+			if err != nil {
+				t.Fatalf("Sign failed: %v", err)
+			}
+
+			headerJson, err := DecodeHeader(token)
+			if err != nil {
+				t.Fatalf("DecodeHeader failed: %v", err)
+			}
+			var header map[string]string
+			if err := json.Unmarshal([]byte(headerJson), &header); err != nil {
+				t.Fatalf("Unmarshal header failed: %v", err)
+			}
+			if header["alg"] != string(tt.alg) || header["typ"] != "JWT" {
+				t.Errorf("Unexpected header: %s", headerJson)
+			}
+			if tt.opts != nil && header["kid"] != tt.opts.Kid {
+				t.Errorf("Unexpected kid: %s", headerJson)
+			}
+
+			verified, err := Verify(token, tt.publicKey, &VerifyOptions{Algorithms: []Algorithm{tt.alg}})
+			if err != nil {
+				t.Fatalf("Verify failed: %v", err)
+			}
+			if verified != `{"sub":"alice","admin":true}` {
+				t.Errorf("Verify returned %s", verified)
+			}
+
+			// 篡改载荷后签名验证失败
+			parts := strings.Split(token, ".")
+			forged := parts[0] + "." + segment(`{"sub":"mallory","admin":true}`) + "." + parts[2]
+			if _, err := Verify(forged, tt.publicKey, nil); !errors.Is(err, ErrVerification) {
+				t.Errorf("Verify forged token = %v, want ErrVerification", err)
+			}
+		})
+	}
+}
+
+func TestEd25519KnownToken(t *testing.T) {
+	// 使用 openssl pkeyutl -rawin 对同一签名输入生成的签名
+	expected := "eyJhbGciOiJFZERTQSIsInR5cCI6IkpXVCJ9." +
+		"eyJzdWIiOiIxMjM0NTY3ODkwIiwiaWF0IjoxNTE2MjM5MDIyfQ." +
+		"TTQHnl_Lkjo62shxwuDreAJn5HigHaqcO472L0a7XPal4RhxU532KuGxxAl6JPtgFvhB6yIZuqBzwq1TqlaGAA"
+
+	seed, _ := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	token, err := Sign(`{"sub":"1234567890","iat":1516239022}`, seed, nil)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if token != expected {
+		t.Errorf("Sign = %s, want %s", token, expected)
+	}
+
+	keyPair, err := ed25519.GenKeyPairFromSeed(seed)
+	if err != nil {
+		t.Fatalf("GenKeyPairFromSeed failed: %v", err)
+	}
+	if _, err := Verify(expected, keyPair.PublicKey, nil); err != nil {
+		t.Errorf("Verify failed: %v", err)
+	}
+}
+
+func TestValidateClaims(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	fixed := func() time.Time { return now }
+
+	tests := []struct {
+		name   string
+		claims string
+		opts   VerifyOptions
+		want   error
+	}{
+		{"valid", `{"exp":1700000060,"nbf":1699999940,"iat":1699999940}`, VerifyOptions{}, nil},
+		{"expired", `{"exp":1700000000}`, VerifyOptions{}, ErrTokenExpired},
+		{"expired within skew", `{"exp":1699999990}`, VerifyOptions{ClockSkew: 30 * time.Second}, nil},
+		{"expired beyond skew", `{"exp":1699999960}`, VerifyOptions{ClockSkew: 30 * time.Second}, ErrTokenExpired},
+		{"not valid yet", `{"nbf":1700000010}`, VerifyOptions{}, ErrTokenNotValidYet},
+		{"not valid yet within skew", `{"nbf":1700000010}`, VerifyOptions{ClockSkew: 30 * time.Second}, nil},
+		{"issued in future", `{"iat":1700000010}`, VerifyOptions{}, ErrTokenIssuedInFuture},
+		{"issuer", `{"iss":"https://issuer.example"}`, VerifyOptions{Issuer: "https://issuer.example"}, nil},
+		{"wrong issuer", `{"iss":"https://evil.example"}`, VerifyOptions{Issuer: "https://issuer.example"}, ErrInvalidIssuer},
+		{"missing issuer", `{}`, VerifyOptions{Issuer: "https://issuer.example"}, ErrInvalidIssuer},
+		{"audience string", `{"aud":"api"}`, VerifyOptions{Audience: "api"}, nil},
+		{"audience array", `{"aud":["web","api"]}`, VerifyOptions{Audience: "api"}, nil},
+		{"wrong audience", `{"aud":["web"]}`, VerifyOptions{Audience: "api"}, ErrInvalidAudience},
+		{"missing audience", `{}`, VerifyOptions{Audience: "api"}, ErrInvalidAudience},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := Sign(tt.claims, ed25519KeyPair.PrivateKey, nil)
+			if err != nil {
+				t.Fatalf("Sign failed: %v", err)
+			}
+
+			tt.opts.Now = fixed
+			_, err = Verify(token, ed25519KeyPair.PublicKey, &tt.opts)
+			if tt.want == nil && err != nil {
+				t.Errorf("Verify failed: %v", err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("Verify = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// 要求 exp 时缺少 exp 的令牌被拒绝
+	token, _ := Sign(`{"sub":"alice"}`, ed25519KeyPair.PrivateKey, nil)
+	if _, err := Verify(token, ed25519KeyPair.PublicKey, &VerifyOptions{RequireExpiration: true}); err == nil {
+		t.Error("Verify should reject token without exp")
+	}
+
+	// exp 必须是数字
+	token, _ = Sign(`{"exp":"tomorrow"}`, ed25519KeyPair.PrivateKey, nil)
+	if _, err := Verify(token, ed25519KeyPair.PublicKey, nil); err == nil {
+		t.Error("Verify should reject non-numeric exp")
+	}
+}
+
+func TestSignAndVerifyShouldFail(t *testing.T) {
+	if _, err := Sign(`["not","an","object"]`, rsaKeyPair.PrivateKey, nil); err == nil {
+		t.Error("Sign should reject non-object claims")
+	}
+	if _, err := Sign(`{}`, []byte("invalid key"), nil); err == nil {
+		t.Error("Sign should reject invalid private key")
+	}
+	if _, err := Sign(`{}`, p256KeyPair.PrivateKey, &SignOptions{Algorithm: ES384}); err == nil {
+		t.Error("Sign should reject algorithm not matching key curve")
+	}
+	if _, err := Sign(`{}`, rsaKeyPair.PrivateKey, &SignOptions{Algorithm: "HS256"}); err == nil {
+		t.Error("Sign should reject unsupported algorithm")
+	}
+
+	token, err := Sign(`{"sub":"alice"}`, rsaKeyPair.PrivateKey, nil)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+
+	// 算法白名单
+	if _, err := Verify(token, rsaKeyPair.PublicKey, &VerifyOptions{Algorithms: []Algorithm{ES256}}); err == nil {
+		t.Error("Verify should reject algorithm not in allow list")
+	}
+
+	// 错误的公钥
+	if _, err := Verify(token, p256KeyPair.PublicKey, nil); err == nil {
+		t.Error("Verify should reject token with wrong key type")
+	}
+
+	// alg 为 none 的令牌
+	parts := strings.Split(token, ".")
+	unsigned := segment(`{"alg":"none"}`) + "." + parts[1] + "."
+	if _, err := Verify(unsigned, rsaKeyPair.PublicKey, nil); err == nil {
+		t.Error("Verify should reject alg none")
+	}
+
+	// 密钥曲线与 alg 不一致
+	es384, err := Sign(`{"sub":"alice"}`, p384KeyPair.PrivateKey, nil)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	es384Parts := strings.Split(es384, ".")
+	downgraded := segment(`{"alg":"ES256","typ":"JWT"}`) + "." + es384Parts[1] + "." + es384Parts[2]
+	if _, err := Verify(downgraded, p384KeyPair.PublicKey, nil); !errors.Is(err, ErrVerification) {
+		t.Errorf("Verify with mismatched curve = %v, want ErrVerification", err)
+	}
+
+	// 不支持的 crit 头
+	critical := segment(`{"alg":"RS256","crit":["exp"]}`) + "." + parts[1] + "." + parts[2]
+	if _, err := Verify(critical, rsaKeyPair.PublicKey, nil); err == nil {
+		t.Error("Verify should reject unknown critical header")
+	}
+
+	for _, invalid := range []string{"", "a.b", "a.b.c.d", "!!!.e30.AA"} {
+		if _, err := Verify(invalid, rsaKeyPair.PublicKey, nil); err == nil {
+			t.Errorf("Verify should reject %q", invalid)
+		}
+	}
+}
+
+func TestDecodeClaims(t *testing.T) {
+	token, err := Sign(`{"sub":"alice","exp":1}`, ed25519KeyPair.PrivateKey, &SignOptions{Kid: "ed-1"})
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+
+	// 解码不验证签名和过期时间
+	claims, err := DecodeClaims(token)
+	if err != nil || claims != `{"sub":"alice","exp":1}` {
+		t.Errorf("DecodeClaims = %s, %v", claims, err)
+	}
+
+	header, err := DecodeHeader(token)
+	if err != nil || header != `{"alg":"EdDSA","kid":"ed-1","typ":"JWT"}` {
+		t.Errorf("DecodeHeader = %s, %v", header, err)
+	}
+}
+
+// segment 将 JSON 编码为令牌的一段
+func segment(s string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+var (
+	rsaKeyPair     = must(rsa.GenKeyPair(2048))
+	p256KeyPair    = must(ecdsa.GenKeyPair(ecdsa.P256))
+	p384KeyPair    = must(ecdsa.GenKeyPair(ecdsa.P384))
+	ed25519KeyPair = must(ed25519.GenKeyPair())
+)
+
+// must 生成测试用的密钥对
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
@@ -4,6 +4,7 @@ package main
 
 import (
 	"syscall/js"
+	"time"
 )
 
 // PromiseFunc 定义需要被Promise化的函数类型
@@ -62,6 +63,319 @@ func copyBytesToJS(bytes []byte) js.Value {
 	return result
 }
 
+// 从JS读取摘要算法名称，未设置时返回0表示使用默认值
+func rsaHashFromJS(value js.Value) (RsaHash, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return 0, nil
+	}
+	return RsaParseHash(value.String())
+}
+
+// 从JS读取曲线名称，未设置时返回0表示使用默认值P-256
+func ecdsaCurveFromJS(value js.Value) (EcdsaCurve, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return 0, nil
+	}
+	return EcdsaParseCurve(value.String())
+}
+
+// 从JS读取ECDH曲线名称，未设置时返回0表示使用默认值X25519
+func ecdhCurveFromJS(value js.Value) (EcdhCurve, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return 0, nil
+	}
+	return EcdhParseCurve(value.String())
+}
+
+// 从JS读取填充方式名称，未设置时返回0表示使用默认值PKCS#7
+func aesPaddingFromJS(value js.Value) (AesPadding, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return 0, nil
+	}
+	return AesParsePadding(value.String())
+}
+
+// 从JS读取SM2密文顺序，未设置时返回0表示使用默认值C1C3C2
+func sm2CiphertextOrderFromJS(value js.Value) (Sm2CiphertextOrder, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return 0, nil
+	}
+	return Sm2ParseCiphertextOrder(value.String())
+}
+
+// 从JS读取SM4填充方式名称，未设置时返回0表示使用默认值PKCS#7
+func sm4PaddingFromJS(value js.Value) (Sm4Padding, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return 0, nil
+	}
+	return Sm4ParsePadding(value.String())
+}
+
+// 从JS读取摘要算法名称，未设置时返回0表示使用默认值SHA-256
+func hashAlgorithmFromJS(value js.Value) (HashAlgorithm, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return 0, nil
+	}
+	return HashParseAlgorithm(value.String())
+}
+
+// 读取JS对象的整数字段，未设置时返回0表示使用默认值
+func intField(value js.Value, name string) int {
+	field := value.Get(name)
+	if field.IsNull() || field.IsUndefined() {
+		return 0
+	}
+	return field.Int()
+}
+
+// 从JS对象读取PBKDF2选项 {hash: "SHA-256", iterations: 600000, keyLength: 32}
+func kdfPbkdf2OptionsFromJS(value js.Value) (*KdfPbkdf2Options, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return nil, nil
+	}
+
+	hash, err := hashAlgorithmFromJS(value.Get("hash"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &KdfPbkdf2Options{
+		Hash:       hash,
+		Iterations: intField(value, "iterations"),
+		KeyLength:  intField(value, "keyLength"),
+	}, nil
+}
+
+// 从JS对象读取scrypt选项 {n: 32768, r: 8, p: 1, keyLength: 32}
+func kdfScryptOptionsFromJS(value js.Value) *KdfScryptOptions {
+	if value.IsNull() || value.IsUndefined() {
+		return nil
+	}
+
+	return &KdfScryptOptions{
+		N:         intField(value, "n"),
+		R:         intField(value, "r"),
+		P:         intField(value, "p"),
+		KeyLength: intField(value, "keyLength"),
+	}
+}
+
+// 从JS对象读取Argon2选项 {time: 2, memory: 19456, threads: 1, keyLength: 32}
+func kdfArgon2OptionsFromJS(value js.Value) *KdfArgon2Options {
+	if value.IsNull() || value.IsUndefined() {
+		return nil
+	}
+
+	return &KdfArgon2Options{
+		Time:      intField(value, "time"),
+		Memory:    intField(value, "memory"),
+		Threads:   intField(value, "threads"),
+		KeyLength: intField(value, "keyLength"),
+	}
+}
+
+// 从JS对象读取JWT签名选项 {algorithm: "RS256", kid: "key-1"}
+func jwtSignOptionsFromJS(value js.Value) *JwtSignOptions {
+	if value.IsNull() || value.IsUndefined() {
+		return nil
+	}
+
+	opts := &JwtSignOptions{}
+	if algorithm := value.Get("algorithm"); !algorithm.IsNull() && !algorithm.IsUndefined() {
+		opts.Algorithm = JwtAlgorithm(algorithm.String())
+	}
+	if kid := value.Get("kid"); !kid.IsNull() && !kid.IsUndefined() {
+		opts.Kid = kid.String()
+	}
+	return opts
+}
+
+// 从JS对象读取JWT验证选项 {algorithms: ["RS256"], issuer: "...", audience: "...", clockSkew: 60}
+func jwtVerifyOptionsFromJS(value js.Value) *JwtVerifyOptions {
+	if value.IsNull() || value.IsUndefined() {
+		return nil
+	}
+
+	opts := &JwtVerifyOptions{
+		// clockSkew 单位为秒
+		ClockSkew: time.Duration(intField(value, "clockSkew")) * time.Second,
+	}
+	if issuer := value.Get("issuer"); !issuer.IsNull() && !issuer.IsUndefined() {
+		opts.Issuer = issuer.String()
+	}
+	if audience := value.Get("audience"); !audience.IsNull() && !audience.IsUndefined() {
+		opts.Audience = audience.String()
+	}
+
+	// algorithms 可以是字符串或字符串数组
+	algorithms := value.Get("algorithms")
+	switch {
+	case algorithms.Type() == js.TypeString:
+		opts.Algorithms = []JwtAlgorithm{JwtAlgorithm(algorithms.String())}
+	case algorithms.Type() == js.TypeObject:
+		for i := 0; i < algorithms.Length(); i++ {
+			opts.Algorithms = append(opts.Algorithms, JwtAlgorithm(algorithms.Index(i).String()))
+		}
+	}
+	return opts
+}
+
+// 从JS对象读取OAEP选项 {hash: "SHA-256", mgfHash: "SHA-1", label: Uint8Array}
+func rsaOaepOptionsFromJS(value js.Value) (*RsaOaepOptions, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return nil, nil
+	}
+
+	hash, err := rsaHashFromJS(value.Get("hash"))
+	if err != nil {
+		return nil, err
+	}
+	mgfHash, err := rsaHashFromJS(value.Get("mgfHash"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &RsaOaepOptions{
+		Hash:    hash,
+		MGFHash: mgfHash,
+		Label:   copyBytesFromJS(value.Get("label")),
+	}, nil
+}
+
+// 从JS对象读取PSS选项 {hash: "SHA-256", saltLength: 32}
+func rsaPssOptionsFromJS(value js.Value) (*RsaPssOptions, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return nil, nil
+	}
+
+	hash, err := rsaHashFromJS(value.Get("hash"))
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &RsaPssOptions{Hash: hash}
+	if saltLength := value.Get("saltLength"); !saltLength.IsNull() && !saltLength.IsUndefined() {
+		opts.SaltLength = saltLength.Int()
+	}
+	return opts, nil
+}
+
+// 从JS对象读取签名选项 {hash: "SHA-256", padding: "PSS", saltLength: 32}
+func rsaSignOptionsFromJS(value js.Value) (*RsaSignOptions, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return nil, nil
+	}
+
+	hash, err := rsaHashFromJS(value.Get("hash"))
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &RsaSignOptions{Hash: hash}
+	if padding := value.Get("padding"); !padding.IsNull() && !padding.IsUndefined() {
+		opts.Padding, err = RsaParsePadding(padding.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if saltLength := value.Get("saltLength"); !saltLength.IsNull() && !saltLength.IsUndefined() {
+		opts.SaltLength = saltLength.Int()
+	}
+	return opts, nil
+}
+
+// 从JS对象读取密钥生成选项 {privateKeyFormat: "PKCS8", publicKeyFormat: "PKIX", encoding: "PEM", publicExponent: 65537}
+func rsaKeyPairOptionsFromJS(value js.Value) (*RsaKeyPairOptions, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return nil, nil
+	}
+
+	var err error
+	opts := &RsaKeyPairOptions{}
+	if privateKeyFormat := value.Get("privateKeyFormat"); !privateKeyFormat.IsNull() && !privateKeyFormat.IsUndefined() {
+		if opts.PrivateKeyFormat, err = RsaParsePrivateKeyFormat(privateKeyFormat.String()); err != nil {
+			return nil, err
+		}
+	}
+	if publicKeyFormat := value.Get("publicKeyFormat"); !publicKeyFormat.IsNull() && !publicKeyFormat.IsUndefined() {
+		if opts.PublicKeyFormat, err = RsaParsePublicKeyFormat(publicKeyFormat.String()); err != nil {
+			return nil, err
+		}
+	}
+	if encoding := value.Get("encoding"); !encoding.IsNull() && !encoding.IsUndefined() {
+		if opts.Encoding, err = RsaParseKeyEncoding(encoding.String()); err != nil {
+			return nil, err
+		}
+	}
+	if publicExponent := value.Get("publicExponent"); !publicExponent.IsNull() && !publicExponent.IsUndefined() {
+		opts.PublicExponent = publicExponent.Int()
+	}
+	return opts, nil
+}
+
+// 从JS对象读取私钥加密选项 {kdf: "scrypt", iterations: 100000, scryptN: 16384, scryptR: 8, scryptP: 1}
+func rsaEncryptPrivateKeyOptionsFromJS(value js.Value) (*RsaEncryptPrivateKeyOptions, error) {
+	if value.IsNull() || value.IsUndefined() {
+		return nil, nil
+	}
+
+	var err error
+	opts := &RsaEncryptPrivateKeyOptions{}
+	if kdf := value.Get("kdf"); !kdf.IsNull() && !kdf.IsUndefined() {
+		if opts.KeyDerivation, err = RsaParseKeyDerivation(kdf.String()); err != nil {
+			return nil, err
+		}
+	}
+	if iterations := value.Get("iterations"); !iterations.IsNull() && !iterations.IsUndefined() {
+		opts.Iterations = iterations.Int()
+	}
+	if scryptN := value.Get("scryptN"); !scryptN.IsNull() && !scryptN.IsUndefined() {
+		opts.ScryptN = scryptN.Int()
+	}
+	if scryptR := value.Get("scryptR"); !scryptR.IsNull() && !scryptR.IsUndefined() {
+		opts.ScryptR = scryptR.Int()
+	}
+	if scryptP := value.Get("scryptP"); !scryptP.IsNull() && !scryptP.IsUndefined() {
+		opts.ScryptP = scryptP.Int()
+	}
+	return opts, nil
+}
+
+// 读取可选参数，不存在时返回undefined
+func optionalArg(args []js.Value, index int) js.Value {
+	if index >= len(args) {
+		return js.Undefined()
+	}
+	return args[index]
+}
+
+// 读取可选字符串参数，不存在时返回空字符串
+func optionalStringArg(args []js.Value, index int) string {
+	value := optionalArg(args, index)
+	if value.IsNull() || value.IsUndefined() {
+		return ""
+	}
+	return value.String()
+}
+
+// 读取可选字节参数，字符串按UTF-8编码，不存在时返回nil
+func optionalBytesArg(args []js.Value, index int) []byte {
+	value := optionalArg(args, index)
+	if value.Type() == js.TypeString {
+		return []byte(value.String())
+	}
+	return copyBytesFromJS(value)
+}
+
+// 读取可选整数参数，不存在时返回0
+func optionalIntArg(args []js.Value, index int) int {
+	value := optionalArg(args, index)
+	if value.IsNull() || value.IsUndefined() {
+		return 0
+	}
+	return value.Int()
+}
+
 // ToPromise 将Go函数封装为返回Promise的JS函数
 func ToPromise(fn PromiseFunc) js.Func {
 	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
@@ -102,15 +416,40 @@ func registerRsaFunctions() {
 	// 生成RSA密钥对
 	js.Global().Set("goRsaGenKeyPair", ToPromise(func(args []js.Value) interface{} {
 		keySize := args[0].Int()
-		kp, err := RsaGenKeyPair(keySize)
+		opts, err := rsaKeyPairOptionsFromJS(optionalArg(args, 1))
 		if err != nil {
 			return errorResponse(err)
 		}
 
-		// 直接返回两个键作为数组 [公钥base64, 私钥base64]
+		if opts == nil {
+			kp, err := RsaGenKeyPair(keySize)
+			if err != nil {
+				return errorResponse(err)
+			}
+
+			// 直接返回两个键作为数组 [公钥base64, 私钥base64]
+			return successResponse([]interface{}{
+				RsaGetPublicKeyBase64(kp),
+				RsaGetPrivateKeyBase64(kp),
+			})
+		}
+
+		opts.KeySize = keySize
+		kp, err := RsaGenKeyPairWithOptions(opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// DER编码（默认）时返回base64，PEM和Base64编码时直接返回文本 [公钥, 私钥]
+		if opts.Encoding == 0 {
+			return successResponse([]interface{}{
+				RsaGetPublicKeyBase64(kp),
+				RsaGetPrivateKeyBase64(kp),
+			})
+		}
 		return successResponse([]interface{}{
-			RsaGetPublicKeyBase64(kp),
-			RsaGetPrivateKeyBase64(kp),
+			string(kp.PublicKey),
+			string(kp.PrivateKey),
 		})
 	}))
 
@@ -269,11 +608,2753 @@ func registerRsaFunctions() {
 		// 直接返回验证结果布尔值
 		return successResponse(verified)
 	}))
+
+	// RSA-OAEP加密（返回Base64编码结果）
+	js.Global().Set("goRsaEncryptOaepBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaOaepOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := RsaEncryptOaepBase64(dataArray, publicKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// RSA-OAEP加密（返回二进制结果）
+	js.Global().Set("goRsaEncryptOaep", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaOaepOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := RsaEncryptOaep(dataArray, publicKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64解密RSA-OAEP
+	js.Global().Set("goRsaDecryptOaepFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaOaepOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := RsaDecryptOaepFromBase64(encrypted, privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// RSA-OAEP解密
+	js.Global().Set("goRsaDecryptOaep", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaOaepOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := RsaDecryptOaep(encryptedArray, privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// RSA-PSS签名（返回Base64编码结果）
+	js.Global().Set("goRsaSignPssBase64", ToPromise(func(args []js.Value) interface{} {
+		data := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaPssOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		signature, err := RsaSignPssBase64(data, privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名后的Base64字符串
+		return successResponse(signature)
+	}))
+
+	// RSA-PSS签名
+	js.Global().Set("goRsaSignPss", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaPssOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		signature, err := RsaSignPss(dataArray, privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名后的字节数组
+		return successResponse(copyBytesToJS(signature))
+	}))
+
+	// 验证Base64编码的RSA-PSS签名
+	js.Global().Set("goRsaVerifyPssFromBase64", ToPromise(func(args []js.Value) interface{} {
+		data := args[0].String()
+		publicKeyArray := copyBytesFromJS(args[1])
+		signature := args[2].String()
+		opts, err := rsaPssOptionsFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		verified, err := RsaVerifyPssFromBase64(data, publicKeyArray, signature, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// 验证RSA-PSS签名
+	js.Global().Set("goRsaVerifyPss", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		signatureArray := copyBytesFromJS(args[2])
+		opts, err := rsaPssOptionsFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		verified, err := RsaVerifyPss(dataArray, publicKeyArray, signatureArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// 按选项进行RSA签名
+	js.Global().Set("goRsaSignWithOptions", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaSignOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		signature, err := RsaSignWithOptions(dataArray, privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名后的字节数组
+		return successResponse(copyBytesToJS(signature))
+	}))
+
+	// 按选项验证RSA签名
+	js.Global().Set("goRsaVerifyWithOptions", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		signatureArray := copyBytesFromJS(args[2])
+		opts, err := rsaSignOptionsFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		verified, err := RsaVerifyWithOptions(dataArray, publicKeyArray, signatureArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// 对预先计算的摘要进行RSA签名
+	js.Global().Set("goRsaSignDigest", ToPromise(func(args []js.Value) interface{} {
+		digestArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaSignOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		signature, err := RsaSignDigest(digestArray, privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名后的字节数组
+		return successResponse(copyBytesToJS(signature))
+	}))
+
+	// 验证预先计算的摘要的RSA签名
+	js.Global().Set("goRsaVerifyDigest", ToPromise(func(args []js.Value) interface{} {
+		digestArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		signatureArray := copyBytesFromJS(args[2])
+		opts, err := rsaSignOptionsFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		verified, err := RsaVerifyDigest(digestArray, publicKeyArray, signatureArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// RSA分段加密（返回Base64编码结果）
+	js.Global().Set("goRsaEncryptSegmentedBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+
+		encrypted, err := RsaEncryptSegmentedBase64(dataArray, publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// RSA分段加密（返回二进制结果）
+	js.Global().Set("goRsaEncryptSegmented", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+
+		encrypted, err := RsaEncryptSegmented(dataArray, publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64分段解密RSA
+	js.Global().Set("goRsaDecryptSegmentedFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+
+		decrypted, err := RsaDecryptSegmentedFromBase64(encrypted, privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// RSA分段解密
+	js.Global().Set("goRsaDecryptSegmented", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+
+		decrypted, err := RsaDecryptSegmented(encryptedArray, privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// RSA-OAEP分段加密（返回Base64编码结果）
+	js.Global().Set("goRsaEncryptOaepSegmentedBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaOaepOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := RsaEncryptOaepSegmentedBase64(dataArray, publicKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// RSA-OAEP分段加密（返回二进制结果）
+	js.Global().Set("goRsaEncryptOaepSegmented", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaOaepOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := RsaEncryptOaepSegmented(dataArray, publicKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64分段解密RSA-OAEP
+	js.Global().Set("goRsaDecryptOaepSegmentedFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaOaepOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := RsaDecryptOaepSegmentedFromBase64(encrypted, privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// RSA-OAEP分段解密
+	js.Global().Set("goRsaDecryptOaepSegmented", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+		opts, err := rsaOaepOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := RsaDecryptOaepSegmented(encryptedArray, privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// 私钥转换为PEM格式
+	js.Global().Set("goRsaPrivateKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := RsaPrivateKeyToPem(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// 公钥转换为PEM格式
+	js.Global().Set("goRsaPublicKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := RsaPublicKeyToPem(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// PEM解码为DER
+	js.Global().Set("goRsaPemToDer", ToPromise(func(args []js.Value) interface{} {
+		pemData := args[0].String()
+
+		der, err := RsaPemToDer(pemData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回DER字节数组
+		return successResponse(copyBytesToJS(der))
+	}))
+
+	// PKCS8私钥转换为PKCS1格式
+	js.Global().Set("goRsaConvertPkcs8ToPkcs1", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		converted, err := RsaConvertPkcs8ToPkcs1(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKCS1私钥字节数组
+		return successResponse(copyBytesToJS(converted))
+	}))
+
+	// PKCS1私钥转换为PKCS8格式
+	js.Global().Set("goRsaConvertPkcs1ToPkcs8", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		converted, err := RsaConvertPkcs1ToPkcs8(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKCS8私钥字节数组
+		return successResponse(copyBytesToJS(converted))
+	}))
+
+	// 公钥转换为PKCS1格式
+	js.Global().Set("goRsaConvertPublicKeyToPkcs1", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		converted, err := RsaConvertPublicKeyToPkcs1(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKCS1公钥字节数组
+		return successResponse(copyBytesToJS(converted))
+	}))
+
+	// 公钥转换为PKIX格式
+	js.Global().Set("goRsaConvertPublicKeyToPkix", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		converted, err := RsaConvertPublicKeyToPkix(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKIX公钥字节数组
+		return successResponse(copyBytesToJS(converted))
+	}))
+
+	// 使用密码加密私钥（PKCS8 EncryptedPrivateKeyInfo DER）
+	js.Global().Set("goRsaEncryptPrivateKey", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+		password := args[1].String()
+		opts, err := rsaEncryptPrivateKeyOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := RsaEncryptPrivateKey(privateKeyArray, []byte(password), opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密私钥字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 使用密码加密私钥（ENCRYPTED PRIVATE KEY PEM）
+	js.Global().Set("goRsaEncryptPrivateKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+		password := args[1].String()
+		opts, err := rsaEncryptPrivateKeyOptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		pemData, err := RsaEncryptPrivateKeyToPem(privateKeyArray, []byte(password), opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// 使用密码解密私钥（DER或PEM），返回PKCS8私钥
+	js.Global().Set("goRsaDecryptPrivateKey", ToPromise(func(args []js.Value) interface{} {
+		encryptedKeyArray := copyBytesFromJS(args[0])
+		password := args[1].String()
+
+		privateKey, err := RsaDecryptPrivateKey(encryptedKeyArray, []byte(password))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKCS8私钥字节数组
+		return successResponse(copyBytesToJS(privateKey))
+	}))
+
+	// 公钥转换为authorized_keys格式，comment可选
+	js.Global().Set("goRsaPublicKeyToOpenSsh", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+		comment := optionalStringArg(args, 1)
+
+		line, err := RsaPublicKeyToOpenSsh(publicKeyArray, comment)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回authorized_keys字符串
+		return successResponse(line)
+	}))
+
+	// 解析authorized_keys格式公钥
+	js.Global().Set("goRsaOpenSshToPublicKey", ToPromise(func(args []js.Value) interface{} {
+		authorizedKey := args[0].String()
+
+		publicKey, err := RsaOpenSshToPublicKey(authorizedKey)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKIX公钥字节数组
+		return successResponse(copyBytesToJS(publicKey))
+	}))
+
+	// 私钥转换为OpenSSH格式，passphrase和comment可选
+	js.Global().Set("goRsaPrivateKeyToOpenSsh", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+		passphrase := optionalStringArg(args, 1)
+		comment := optionalStringArg(args, 2)
+
+		pemData, err := RsaPrivateKeyToOpenSsh(privateKeyArray, []byte(passphrase), comment)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// 解析OpenSSH私钥，passphrase可选
+	js.Global().Set("goRsaOpenSshToPrivateKey", ToPromise(func(args []js.Value) interface{} {
+		pemData := args[0].String()
+		passphrase := optionalStringArg(args, 1)
+
+		privateKey, err := RsaOpenSshToPrivateKey(pemData, []byte(passphrase))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKCS1私钥字节数组
+		return successResponse(copyBytesToJS(privateKey))
+	}))
+
+	// 计算公钥SHA256指纹
+	js.Global().Set("goRsaOpenSshFingerprint", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		fingerprint, err := RsaOpenSshFingerprint(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回指纹字符串
+		return successResponse(fingerprint)
+	}))
+}
+
+// 信封加密函数导出
+func registerEnvelopeFunctions() {
+	// 信封加密（返回Base64编码结果）
+	js.Global().Set("goEnvelopeSealBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+
+		sealed, err := EnvelopeSealBase64(dataArray, publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回信封的Base64字符串
+		return successResponse(sealed)
+	}))
+
+	// 信封加密（返回二进制结果）
+	js.Global().Set("goEnvelopeSeal", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+
+		sealed, err := EnvelopeSeal(dataArray, publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回信封字节数组
+		return successResponse(copyBytesToJS(sealed))
+	}))
+
+	// 从Base64解密信封
+	js.Global().Set("goEnvelopeOpenFromBase64", ToPromise(func(args []js.Value) interface{} {
+		sealed := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+
+		opened, err := EnvelopeOpenFromBase64(sealed, privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(opened))
+	}))
+
+	// 解密信封
+	js.Global().Set("goEnvelopeOpen", ToPromise(func(args []js.Value) interface{} {
+		sealedArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+
+		opened, err := EnvelopeOpen(sealedArray, privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(opened))
+	}))
+}
+
+// ECDSA函数导出
+func registerEcdsaFunctions() {
+	// 生成ECDSA密钥对，曲线可选，默认P-256
+	js.Global().Set("goEcdsaGenKeyPair", ToPromise(func(args []js.Value) interface{} {
+		curve, err := ecdsaCurveFromJS(optionalArg(args, 0))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		kp, err := EcdsaGenKeyPair(curve)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回两个键作为数组 [公钥base64, 私钥base64]
+		return successResponse([]interface{}{
+			EcdsaGetPublicKeyBase64(kp),
+			EcdsaGetPrivateKeyBase64(kp),
+		})
+	}))
+
+	// 从私钥提取公钥
+	js.Global().Set("goEcdsaExtractPublicKey", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		publicKey, err := EcdsaExtractPublicKey(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回公钥字节数组
+		return successResponse(copyBytesToJS(publicKey))
+	}))
+
+	// ECDSA签名（返回Base64编码结果）
+	js.Global().Set("goEcdsaSignBase64", ToPromise(func(args []js.Value) interface{} {
+		data := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+
+		signature, err := EcdsaSignBase64(data, privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名后的Base64字符串
+		return successResponse(signature)
+	}))
+
+	// ECDSA签名（DER格式）
+	js.Global().Set("goEcdsaSign", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+
+		signature, err := EcdsaSign(dataArray, privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名字节数组
+		return successResponse(copyBytesToJS(signature))
+	}))
+
+	// ECDSA签名（r||s格式，与WebCrypto一致）
+	js.Global().Set("goEcdsaSignRaw", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+
+		signature, err := EcdsaSignRaw(dataArray, privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名字节数组
+		return successResponse(copyBytesToJS(signature))
+	}))
+
+	// 从Base64验证ECDSA签名
+	js.Global().Set("goEcdsaVerifyFromBase64", ToPromise(func(args []js.Value) interface{} {
+		data := args[0].String()
+		publicKeyArray := copyBytesFromJS(args[1])
+		signature := args[2].String()
+
+		verified, err := EcdsaVerifyFromBase64(data, publicKeyArray, signature)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// 验证ECDSA签名（DER格式）
+	js.Global().Set("goEcdsaVerify", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		signatureArray := copyBytesFromJS(args[2])
+
+		verified, err := EcdsaVerify(dataArray, publicKeyArray, signatureArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// 验证ECDSA签名（r||s格式）
+	js.Global().Set("goEcdsaVerifyRaw", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		signatureArray := copyBytesFromJS(args[2])
+
+		verified, err := EcdsaVerifyRaw(dataArray, publicKeyArray, signatureArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// DER签名转换为r||s格式，曲线必须指定
+	js.Global().Set("goEcdsaDerToRawSignature", ToPromise(func(args []js.Value) interface{} {
+		signatureArray := copyBytesFromJS(args[0])
+		curve, err := EcdsaParseCurve(optionalStringArg(args, 1))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		raw, err := EcdsaDerToRawSignature(signatureArray, curve)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名字节数组
+		return successResponse(copyBytesToJS(raw))
+	}))
+
+	// r||s签名转换为DER格式
+	js.Global().Set("goEcdsaRawToDerSignature", ToPromise(func(args []js.Value) interface{} {
+		signatureArray := copyBytesFromJS(args[0])
+
+		der, err := EcdsaRawToDerSignature(signatureArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名字节数组
+		return successResponse(copyBytesToJS(der))
+	}))
+
+	// PKCS8私钥转换为SEC1格式
+	js.Global().Set("goEcdsaConvertPkcs8ToSec1", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		converted, err := EcdsaConvertPkcs8ToSec1(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回SEC1私钥字节数组
+		return successResponse(copyBytesToJS(converted))
+	}))
+
+	// SEC1私钥转换为PKCS8格式
+	js.Global().Set("goEcdsaConvertSec1ToPkcs8", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		converted, err := EcdsaConvertSec1ToPkcs8(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKCS8私钥字节数组
+		return successResponse(copyBytesToJS(converted))
+	}))
+
+	// 私钥转换为PEM格式
+	js.Global().Set("goEcdsaPrivateKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := EcdsaPrivateKeyToPem(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// 公钥转换为PEM格式
+	js.Global().Set("goEcdsaPublicKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := EcdsaPublicKeyToPem(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+}
+
+// Ed25519函数导出
+func registerEd25519Functions() {
+	// 生成Ed25519密钥对，可选传入32字节种子
+	js.Global().Set("goEd25519GenKeyPair", ToPromise(func(args []js.Value) interface{} {
+		var kp *Ed25519KeyPair
+		var err error
+		if seed := optionalArg(args, 0); seed.IsNull() || seed.IsUndefined() {
+			kp, err = Ed25519GenKeyPair()
+		} else {
+			kp, err = Ed25519GenKeyPairFromSeed(copyBytesFromJS(seed))
+		}
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回两个键作为数组 [公钥base64, 私钥base64]
+		return successResponse([]interface{}{
+			Ed25519GetPublicKeyBase64(kp),
+			Ed25519GetPrivateKeyBase64(kp),
+		})
+	}))
+
+	// 从私钥提取公钥
+	js.Global().Set("goEd25519ExtractPublicKey", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		publicKey, err := Ed25519ExtractPublicKey(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回公钥字节数组
+		return successResponse(copyBytesToJS(publicKey))
+	}))
+
+	// 私钥转换为32字节种子
+	js.Global().Set("goEd25519PrivateKeyToSeed", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		seed, err := Ed25519PrivateKeyToSeed(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回种子字节数组
+		return successResponse(copyBytesToJS(seed))
+	}))
+
+	// 公钥转换为32字节原始格式
+	js.Global().Set("goEd25519PublicKeyToRaw", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		raw, err := Ed25519PublicKeyToRaw(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回原始公钥字节数组
+		return successResponse(copyBytesToJS(raw))
+	}))
+
+	// 32字节原始公钥转换为PKIX格式
+	js.Global().Set("goEd25519RawToPublicKey", ToPromise(func(args []js.Value) interface{} {
+		rawArray := copyBytesFromJS(args[0])
+
+		publicKey, err := Ed25519RawToPublicKey(rawArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKIX公钥字节数组
+		return successResponse(copyBytesToJS(publicKey))
+	}))
+
+	// 私钥转换为PEM格式
+	js.Global().Set("goEd25519PrivateKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := Ed25519PrivateKeyToPem(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// 公钥转换为PEM格式
+	js.Global().Set("goEd25519PublicKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := Ed25519PublicKeyToPem(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// Ed25519签名（返回Base64编码结果）
+	js.Global().Set("goEd25519SignBase64", ToPromise(func(args []js.Value) interface{} {
+		data := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+
+		signature, err := Ed25519SignBase64(data, privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名后的Base64字符串
+		return successResponse(signature)
+	}))
+
+	// Ed25519签名
+	js.Global().Set("goEd25519Sign", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+
+		signature, err := Ed25519Sign(dataArray, privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名字节数组
+		return successResponse(copyBytesToJS(signature))
+	}))
+
+	// 从Base64验证Ed25519签名
+	js.Global().Set("goEd25519VerifyFromBase64", ToPromise(func(args []js.Value) interface{} {
+		data := args[0].String()
+		publicKeyArray := copyBytesFromJS(args[1])
+		signature := args[2].String()
+
+		verified, err := Ed25519VerifyFromBase64(data, publicKeyArray, signature)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// 验证Ed25519签名
+	js.Global().Set("goEd25519Verify", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		signatureArray := copyBytesFromJS(args[2])
+
+		verified, err := Ed25519Verify(dataArray, publicKeyArray, signatureArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+}
+
+// ECDH函数导出
+func registerEcdhFunctions() {
+	// 生成ECDH密钥对
+	js.Global().Set("goEcdhGenKeyPair", ToPromise(func(args []js.Value) interface{} {
+		curve, err := ecdhCurveFromJS(optionalArg(args, 0))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		kp, err := EcdhGenKeyPair(curve)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回两个键作为数组 [公钥base64, 私钥base64]
+		return successResponse([]interface{}{
+			EcdhGetPublicKeyBase64(kp),
+			EcdhGetPrivateKeyBase64(kp),
+		})
+	}))
+
+	// 从私钥提取公钥
+	js.Global().Set("goEcdhExtractPublicKey", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		publicKey, err := EcdhExtractPublicKey(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回公钥字节数组
+		return successResponse(copyBytesToJS(publicKey))
+	}))
+
+	// 公钥转换为原始格式
+	js.Global().Set("goEcdhPublicKeyToRaw", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		raw, err := EcdhPublicKeyToRaw(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回原始公钥字节数组
+		return successResponse(copyBytesToJS(raw))
+	}))
+
+	// 原始公钥转换为PKIX格式
+	js.Global().Set("goEcdhRawToPublicKey", ToPromise(func(args []js.Value) interface{} {
+		rawArray := copyBytesFromJS(args[0])
+
+		publicKey, err := EcdhRawToPublicKey(rawArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PKIX公钥字节数组
+		return successResponse(copyBytesToJS(publicKey))
+	}))
+
+	// 私钥转换为PEM格式
+	js.Global().Set("goEcdhPrivateKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := EcdhPrivateKeyToPem(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// 公钥转换为PEM格式
+	js.Global().Set("goEcdhPublicKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := EcdhPublicKeyToPem(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// 计算共享密钥
+	js.Global().Set("goEcdhSharedSecret", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+		peerPublicKeyArray := copyBytesFromJS(args[1])
+
+		sharedSecret, err := EcdhSharedSecret(privateKeyArray, peerPublicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回共享密钥字节数组
+		return successResponse(copyBytesToJS(sharedSecret))
+	}))
+
+	// 使用HKDF-SHA256从共享密钥派生对称密钥，salt和info可选
+	js.Global().Set("goEcdhDeriveKey", ToPromise(func(args []js.Value) interface{} {
+		sharedSecretArray := copyBytesFromJS(args[0])
+		salt := optionalBytesArg(args, 1)
+		info := optionalBytesArg(args, 2)
+		length := optionalIntArg(args, 3)
+
+		key, err := EcdhDeriveKey(sharedSecretArray, salt, info, length)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回密钥字节数组
+		return successResponse(copyBytesToJS(key))
+	}))
+
+	// 计算共享密钥并派生会话密钥，salt和info可选
+	js.Global().Set("goEcdhDeriveSessionKey", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+		peerPublicKeyArray := copyBytesFromJS(args[1])
+		salt := optionalBytesArg(args, 2)
+		info := optionalBytesArg(args, 3)
+		length := optionalIntArg(args, 4)
+
+		key, err := EcdhDeriveSessionKey(privateKeyArray, peerPublicKeyArray, salt, info, length)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回会话密钥字节数组
+		return successResponse(copyBytesToJS(key))
+	}))
+}
+
+// AES函数导出
+func registerAesFunctions() {
+	// 生成AES密钥，默认256位
+	js.Global().Set("goAesGenKey", ToPromise(func(args []js.Value) interface{} {
+		key, err := AesGenKey(optionalIntArg(args, 0))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回密钥字节数组
+		return successResponse(copyBytesToJS(key))
+	}))
+
+	// AES-GCM加密（返回Base64编码结果），附加数据可选
+	js.Global().Set("goAesEncryptGcmBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		encrypted, err := AesEncryptGcmBase64(dataArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// AES-GCM加密，附加数据可选
+	js.Global().Set("goAesEncryptGcm", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		encrypted, err := AesEncryptGcm(dataArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64进行AES-GCM解密，附加数据可选
+	js.Global().Set("goAesDecryptGcmFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		decrypted, err := AesDecryptGcmFromBase64(encrypted, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// AES-GCM解密，附加数据可选
+	js.Global().Set("goAesDecryptGcm", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		decrypted, err := AesDecryptGcm(encryptedArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// 生成随机IV
+	js.Global().Set("goAesGenIv", ToPromise(func(args []js.Value) interface{} {
+		iv, err := AesGenIv()
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回IV字节数组
+		return successResponse(copyBytesToJS(iv))
+	}))
+
+	// AES-CBC加密（返回Base64编码结果），填充方式可选
+	js.Global().Set("goAesEncryptCbcBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := aesPaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := AesEncryptCbcBase64(dataArray, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// AES-CBC加密（返回十六进制编码结果），填充方式可选
+	js.Global().Set("goAesEncryptCbcHex", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := aesPaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := AesEncryptCbcHex(dataArray, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的十六进制字符串
+		return successResponse(encrypted)
+	}))
+
+	// AES-CBC加密，填充方式可选
+	js.Global().Set("goAesEncryptCbc", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := aesPaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := AesEncryptCbc(dataArray, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64进行AES-CBC解密，填充方式可选
+	js.Global().Set("goAesDecryptCbcFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := aesPaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := AesDecryptCbcFromBase64(encrypted, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// 从十六进制进行AES-CBC解密，填充方式可选
+	js.Global().Set("goAesDecryptCbcFromHex", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := aesPaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := AesDecryptCbcFromHex(encrypted, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// AES-CBC解密，填充方式可选
+	js.Global().Set("goAesDecryptCbc", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := aesPaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := AesDecryptCbc(encryptedArray, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// AES-CTR加密（返回Base64编码结果）
+	js.Global().Set("goAesEncryptCtrBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		encrypted, err := AesEncryptCtrBase64(dataArray, keyArray, ivArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// AES-CTR加密（返回十六进制编码结果）
+	js.Global().Set("goAesEncryptCtrHex", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		encrypted, err := AesEncryptCtrHex(dataArray, keyArray, ivArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的十六进制字符串
+		return successResponse(encrypted)
+	}))
+
+	// AES-CTR加密
+	js.Global().Set("goAesEncryptCtr", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		encrypted, err := AesEncryptCtr(dataArray, keyArray, ivArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64进行AES-CTR解密
+	js.Global().Set("goAesDecryptCtrFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		decrypted, err := AesDecryptCtrFromBase64(encrypted, keyArray, ivArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// 从十六进制进行AES-CTR解密
+	js.Global().Set("goAesDecryptCtrFromHex", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		decrypted, err := AesDecryptCtrFromHex(encrypted, keyArray, ivArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// AES-CTR解密
+	js.Global().Set("goAesDecryptCtr", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		decrypted, err := AesDecryptCtr(encryptedArray, keyArray, ivArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+}
+
+// ChaCha20-Poly1305函数导出
+func registerChaCha20Poly1305Functions() {
+	// 生成32字节随机密钥
+	js.Global().Set("goChaCha20Poly1305GenKey", ToPromise(func(args []js.Value) interface{} {
+		key, err := ChaCha20Poly1305GenKey()
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回密钥字节数组
+		return successResponse(copyBytesToJS(key))
+	}))
+
+	// ChaCha20-Poly1305加密（返回Base64编码结果），附加数据可选
+	js.Global().Set("goChaCha20Poly1305EncryptBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		encrypted, err := ChaCha20Poly1305EncryptBase64(dataArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// ChaCha20-Poly1305加密，附加数据可选
+	js.Global().Set("goChaCha20Poly1305Encrypt", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		encrypted, err := ChaCha20Poly1305Encrypt(dataArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64进行ChaCha20-Poly1305解密，附加数据可选
+	js.Global().Set("goChaCha20Poly1305DecryptFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		decrypted, err := ChaCha20Poly1305DecryptFromBase64(encrypted, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// ChaCha20-Poly1305解密，附加数据可选
+	js.Global().Set("goChaCha20Poly1305Decrypt", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		decrypted, err := ChaCha20Poly1305Decrypt(encryptedArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// XChaCha20-Poly1305加密（返回Base64编码结果），附加数据可选
+	js.Global().Set("goXChaCha20Poly1305EncryptBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		encrypted, err := XChaCha20Poly1305EncryptBase64(dataArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// XChaCha20-Poly1305加密，附加数据可选
+	js.Global().Set("goXChaCha20Poly1305Encrypt", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		encrypted, err := XChaCha20Poly1305Encrypt(dataArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64进行XChaCha20-Poly1305解密，附加数据可选
+	js.Global().Set("goXChaCha20Poly1305DecryptFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		decrypted, err := XChaCha20Poly1305DecryptFromBase64(encrypted, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// XChaCha20-Poly1305解密，附加数据可选
+	js.Global().Set("goXChaCha20Poly1305Decrypt", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		decrypted, err := XChaCha20Poly1305Decrypt(encryptedArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+}
+
+// SM2函数导出
+func registerSm2Functions() {
+	// 生成SM2密钥对
+	js.Global().Set("goSm2GenKeyPair", ToPromise(func(args []js.Value) interface{} {
+		kp, err := Sm2GenKeyPair()
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回两个键作为数组 [公钥base64, 私钥base64]
+		return successResponse([]interface{}{
+			Sm2GetPublicKeyBase64(kp),
+			Sm2GetPrivateKeyBase64(kp),
+		})
+	}))
+
+	// 从私钥提取公钥
+	js.Global().Set("goSm2ExtractPublicKey", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		publicKey, err := Sm2ExtractPublicKey(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回公钥字节数组
+		return successResponse(copyBytesToJS(publicKey))
+	}))
+
+	// 私钥转换为PEM格式
+	js.Global().Set("goSm2PrivateKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := Sm2PrivateKeyToPem(privateKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// 公钥转换为PEM格式
+	js.Global().Set("goSm2PublicKeyToPem", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+
+		pemData, err := Sm2PublicKeyToPem(publicKeyArray)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PEM字符串
+		return successResponse(pemData)
+	}))
+
+	// SM2签名（返回Base64编码结果），用户ID可选
+	js.Global().Set("goSm2SignBase64", ToPromise(func(args []js.Value) interface{} {
+		data := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+		uid := optionalBytesArg(args, 2)
+
+		signature, err := Sm2SignBase64(data, privateKeyArray, uid)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名后的Base64字符串
+		return successResponse(signature)
+	}))
+
+	// SM2签名（DER格式），用户ID可选
+	js.Global().Set("goSm2Sign", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+		uid := optionalBytesArg(args, 2)
+
+		signature, err := Sm2Sign(dataArray, privateKeyArray, uid)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名字节数组
+		return successResponse(copyBytesToJS(signature))
+	}))
+
+	// 从Base64验证SM2签名，用户ID可选
+	js.Global().Set("goSm2VerifyFromBase64", ToPromise(func(args []js.Value) interface{} {
+		data := args[0].String()
+		publicKeyArray := copyBytesFromJS(args[1])
+		signature := args[2].String()
+		uid := optionalBytesArg(args, 3)
+
+		verified, err := Sm2VerifyFromBase64(data, publicKeyArray, signature, uid)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// 验证SM2签名（DER格式），用户ID可选
+	js.Global().Set("goSm2Verify", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		signatureArray := copyBytesFromJS(args[2])
+		uid := optionalBytesArg(args, 3)
+
+		verified, err := Sm2Verify(dataArray, publicKeyArray, signatureArray, uid)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// SM2加密（返回Base64编码结果），密文顺序可选
+	js.Global().Set("goSm2EncryptBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		order, err := sm2CiphertextOrderFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := Sm2EncryptBase64(dataArray, publicKeyArray, order)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// SM2加密，密文顺序可选
+	js.Global().Set("goSm2Encrypt", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		publicKeyArray := copyBytesFromJS(args[1])
+		order, err := sm2CiphertextOrderFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := Sm2Encrypt(dataArray, publicKeyArray, order)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64进行SM2解密，密文顺序可选
+	js.Global().Set("goSm2DecryptFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+		order, err := sm2CiphertextOrderFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := Sm2DecryptFromBase64(encrypted, privateKeyArray, order)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// SM2解密，密文顺序可选
+	js.Global().Set("goSm2Decrypt", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		privateKeyArray := copyBytesFromJS(args[1])
+		order, err := sm2CiphertextOrderFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := Sm2Decrypt(encryptedArray, privateKeyArray, order)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+}
+
+// SM3函数导出
+func registerSm3Functions() {
+	// 计算SM3摘要
+	js.Global().Set("goSm3Sum", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+
+		sum := Sm3Sum(dataArray)
+
+		// 直接返回摘要字节数组
+		return successResponse(copyBytesToJS(sum))
+	}))
+
+	// 计算SM3摘要（返回十六进制编码结果）
+	js.Global().Set("goSm3SumHex", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+
+		sum := Sm3SumHex(dataArray)
+
+		// 直接返回摘要十六进制字符串
+		return successResponse(sum)
+	}))
+
+	// 计算HMAC-SM3
+	js.Global().Set("goSm3Hmac", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+
+		mac := Sm3Hmac(dataArray, keyArray)
+
+		// 直接返回HMAC字节数组
+		return successResponse(copyBytesToJS(mac))
+	}))
+
+	// 计算HMAC-SM3（返回十六进制编码结果）
+	js.Global().Set("goSm3HmacHex", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+
+		mac := Sm3HmacHex(dataArray, keyArray)
+
+		// 直接返回HMAC十六进制字符串
+		return successResponse(mac)
+	}))
+
+	// 验证HMAC-SM3
+	js.Global().Set("goSm3VerifyHmac", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		macArray := copyBytesFromJS(args[2])
+
+		verified := Sm3VerifyHmac(dataArray, keyArray, macArray)
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+}
+
+// SM4函数导出
+func registerSm4Functions() {
+	// 生成16字节随机密钥
+	js.Global().Set("goSm4GenKey", ToPromise(func(args []js.Value) interface{} {
+		key, err := Sm4GenKey()
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回密钥字节数组
+		return successResponse(copyBytesToJS(key))
+	}))
+
+	// 生成随机IV
+	js.Global().Set("goSm4GenIv", ToPromise(func(args []js.Value) interface{} {
+		iv, err := Sm4GenIv()
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回IV字节数组
+		return successResponse(copyBytesToJS(iv))
+	}))
+
+	// SM4-ECB加密（返回Base64编码结果），填充方式可选
+	js.Global().Set("goSm4EncryptEcbBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := Sm4EncryptEcbBase64(dataArray, keyArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// SM4-ECB加密（返回十六进制编码结果），填充方式可选
+	js.Global().Set("goSm4EncryptEcbHex", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := Sm4EncryptEcbHex(dataArray, keyArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的十六进制字符串
+		return successResponse(encrypted)
+	}))
+
+	// SM4-ECB加密，填充方式可选
+	js.Global().Set("goSm4EncryptEcb", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := Sm4EncryptEcb(dataArray, keyArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64进行SM4-ECB解密，填充方式可选
+	js.Global().Set("goSm4DecryptEcbFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := Sm4DecryptEcbFromBase64(encrypted, keyArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// 从十六进制进行SM4-ECB解密，填充方式可选
+	js.Global().Set("goSm4DecryptEcbFromHex", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := Sm4DecryptEcbFromHex(encrypted, keyArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// SM4-ECB解密，填充方式可选
+	js.Global().Set("goSm4DecryptEcb", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := Sm4DecryptEcb(encryptedArray, keyArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// SM4-CBC加密（返回Base64编码结果），填充方式可选
+	js.Global().Set("goSm4EncryptCbcBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := Sm4EncryptCbcBase64(dataArray, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// SM4-CBC加密（返回十六进制编码结果），填充方式可选
+	js.Global().Set("goSm4EncryptCbcHex", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := Sm4EncryptCbcHex(dataArray, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的十六进制字符串
+		return successResponse(encrypted)
+	}))
+
+	// SM4-CBC加密，填充方式可选
+	js.Global().Set("goSm4EncryptCbc", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		encrypted, err := Sm4EncryptCbc(dataArray, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64进行SM4-CBC解密，填充方式可选
+	js.Global().Set("goSm4DecryptCbcFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := Sm4DecryptCbcFromBase64(encrypted, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// 从十六进制进行SM4-CBC解密，填充方式可选
+	js.Global().Set("goSm4DecryptCbcFromHex", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := Sm4DecryptCbcFromHex(encrypted, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// SM4-CBC解密，填充方式可选
+	js.Global().Set("goSm4DecryptCbc", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		ivArray := copyBytesFromJS(args[2])
+		padding, err := sm4PaddingFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		decrypted, err := Sm4DecryptCbc(encryptedArray, keyArray, ivArray, padding)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// SM4-GCM加密（返回Base64编码结果），附加数据可选
+	js.Global().Set("goSm4EncryptGcmBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		encrypted, err := Sm4EncryptGcmBase64(dataArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的Base64字符串
+		return successResponse(encrypted)
+	}))
+
+	// SM4-GCM加密，附加数据可选
+	js.Global().Set("goSm4EncryptGcm", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		encrypted, err := Sm4EncryptGcm(dataArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回加密后的字节数组
+		return successResponse(copyBytesToJS(encrypted))
+	}))
+
+	// 从Base64进行SM4-GCM解密，附加数据可选
+	js.Global().Set("goSm4DecryptGcmFromBase64", ToPromise(func(args []js.Value) interface{} {
+		encrypted := args[0].String()
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		decrypted, err := Sm4DecryptGcmFromBase64(encrypted, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+
+	// SM4-GCM解密，附加数据可选
+	js.Global().Set("goSm4DecryptGcm", ToPromise(func(args []js.Value) interface{} {
+		encryptedArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		additionalData := optionalBytesArg(args, 2)
+
+		decrypted, err := Sm4DecryptGcm(encryptedArray, keyArray, additionalData)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回解密后的字节数组
+		return successResponse(copyBytesToJS(decrypted))
+	}))
+}
+
+// 摘要函数导出
+func registerHashFunctions() {
+	// 计算摘要，算法可选，默认SHA-256
+	js.Global().Set("goHashSum", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		algorithm, err := hashAlgorithmFromJS(optionalArg(args, 1))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		digest, err := HashSum(dataArray, algorithm)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回摘要字节数组
+		return successResponse(copyBytesToJS(digest))
+	}))
+
+	// 计算摘要（返回十六进制编码结果），算法可选
+	js.Global().Set("goHashSumHex", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		algorithm, err := hashAlgorithmFromJS(optionalArg(args, 1))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		digest, err := HashSumHex(dataArray, algorithm)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回摘要十六进制字符串
+		return successResponse(digest)
+	}))
+
+	// 计算摘要（返回Base64编码结果），算法可选
+	js.Global().Set("goHashSumBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		algorithm, err := hashAlgorithmFromJS(optionalArg(args, 1))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		digest, err := HashSumBase64(dataArray, algorithm)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回摘要Base64字符串
+		return successResponse(digest)
+	}))
+
+	// 计算HMAC，算法可选，默认SHA-256
+	js.Global().Set("goHashHmac", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		algorithm, err := hashAlgorithmFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		mac, err := HashHmac(dataArray, keyArray, algorithm)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回HMAC字节数组
+		return successResponse(copyBytesToJS(mac))
+	}))
+
+	// 计算HMAC（返回十六进制编码结果），算法可选
+	js.Global().Set("goHashHmacHex", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		algorithm, err := hashAlgorithmFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		mac, err := HashHmacHex(dataArray, keyArray, algorithm)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回HMAC十六进制字符串
+		return successResponse(mac)
+	}))
+
+	// 计算HMAC（返回Base64编码结果），算法可选
+	js.Global().Set("goHashHmacBase64", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		algorithm, err := hashAlgorithmFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		mac, err := HashHmacBase64(dataArray, keyArray, algorithm)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回HMAC Base64字符串
+		return successResponse(mac)
+	}))
+
+	// 验证HMAC，算法可选
+	js.Global().Set("goHashVerifyHmac", ToPromise(func(args []js.Value) interface{} {
+		dataArray := copyBytesFromJS(args[0])
+		keyArray := copyBytesFromJS(args[1])
+		macArray := copyBytesFromJS(args[2])
+		algorithm, err := hashAlgorithmFromJS(optionalArg(args, 3))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		verified, err := HashVerifyHmac(dataArray, keyArray, macArray, algorithm)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+}
+
+// 流式摘要函数导出
+func registerHashStreamFunctions() {
+	// 创建摘要计算器，算法可选，默认SHA-256
+	js.Global().Set("goHashCreate", ToPromise(func(args []js.Value) interface{} {
+		algorithm, err := hashAlgorithmFromJS(optionalArg(args, 0))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		hasher, err := HashNew(algorithm)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回句柄数值
+		return successResponse(newHandle(hasher))
+	}))
+
+	// 创建HMAC计算器，算法可选，默认SHA-256
+	js.Global().Set("goHashCreateHmac", ToPromise(func(args []js.Value) interface{} {
+		keyArray := copyBytesFromJS(args[0])
+		algorithm, err := hashAlgorithmFromJS(optionalArg(args, 1))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		hasher, err := HashNewHmac(keyArray, algorithm)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回句柄数值
+		return successResponse(newHandle(hasher))
+	}))
+
+	// 向摘要计算器追加数据，需等待上一次调用完成后再调用
+	js.Global().Set("goHashUpdate", ToPromise(func(args []js.Value) interface{} {
+		hasher, err := loadHandle[*HashHasher](uint64(args[0].Int()))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		hasher.Update(copyBytesFromJS(args[1]))
+
+		// 直接返回成功状态
+		return successResponse(nil)
+	}))
+
+	// 返回已写入全部数据的摘要，句柄需调用goHashFree释放
+	js.Global().Set("goHashFinish", ToPromise(func(args []js.Value) interface{} {
+		hasher, err := loadHandle[*HashHasher](uint64(args[0].Int()))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回摘要字节数组
+		return successResponse(copyBytesToJS(hasher.Final()))
+	}))
+
+	// 释放摘要计算器句柄
+	js.Global().Set("goHashFree", ToPromise(func(args []js.Value) interface{} {
+		if err := freeHandle[*HashHasher](uint64(args[0].Int())); err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回成功状态
+		return successResponse(nil)
+	}))
+}
+
+// 流式RSA签名函数导出
+func registerRsaStreamFunctions() {
+	// 创建RSA签名器，选项与goRsaSignWithOptions相同
+	js.Global().Set("goRsaSignCreate", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+		opts, err := rsaSignOptionsFromJS(optionalArg(args, 1))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		signer, err := RsaNewSigner(privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回句柄数值
+		return successResponse(newHandle(signer))
+	}))
+
+	// 向RSA签名器追加数据，需等待上一次调用完成后再调用
+	js.Global().Set("goRsaSignUpdate", ToPromise(func(args []js.Value) interface{} {
+		signer, err := loadHandle[*RsaSigner](uint64(args[0].Int()))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		signer.Update(copyBytesFromJS(args[1]))
+
+		// 直接返回成功状态
+		return successResponse(nil)
+	}))
+
+	// 签名已写入的全部数据，句柄需调用goRsaSignFree释放
+	js.Global().Set("goRsaSignFinish", ToPromise(func(args []js.Value) interface{} {
+		signer, err := loadHandle[*RsaSigner](uint64(args[0].Int()))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		signature, err := signer.Sign()
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回签名字节数组
+		return successResponse(copyBytesToJS(signature))
+	}))
+
+	// 释放RSA签名器句柄
+	js.Global().Set("goRsaSignFree", ToPromise(func(args []js.Value) interface{} {
+		if err := freeHandle[*RsaSigner](uint64(args[0].Int())); err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回成功状态
+		return successResponse(nil)
+	}))
+
+	// 创建RSA验证器，选项与goRsaVerifyWithOptions相同
+	js.Global().Set("goRsaVerifyCreate", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+		opts, err := rsaSignOptionsFromJS(optionalArg(args, 1))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		verifier, err := RsaNewVerifier(publicKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回句柄数值
+		return successResponse(newHandle(verifier))
+	}))
+
+	// 向RSA验证器追加数据，需等待上一次调用完成后再调用
+	js.Global().Set("goRsaVerifyUpdate", ToPromise(func(args []js.Value) interface{} {
+		verifier, err := loadHandle[*RsaVerifier](uint64(args[0].Int()))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		verifier.Update(copyBytesFromJS(args[1]))
+
+		// 直接返回成功状态
+		return successResponse(nil)
+	}))
+
+	// 验证已写入的全部数据的签名，句柄需调用goRsaVerifyFree释放
+	js.Global().Set("goRsaVerifyFinish", ToPromise(func(args []js.Value) interface{} {
+		verifier, err := loadHandle[*RsaVerifier](uint64(args[0].Int()))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		verified, err := verifier.Verify(copyBytesFromJS(args[1]))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+
+	// 释放RSA验证器句柄
+	js.Global().Set("goRsaVerifyFree", ToPromise(func(args []js.Value) interface{} {
+		if err := freeHandle[*RsaVerifier](uint64(args[0].Int())); err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回成功状态
+		return successResponse(nil)
+	}))
+}
+
+// 密钥派生函数导出
+func registerKdfFunctions() {
+	// PBKDF2派生密钥，口令可为字符串或Uint8Array，选项可选
+	js.Global().Set("goKdfPbkdf2", ToPromise(func(args []js.Value) interface{} {
+		passwordBytes := optionalBytesArg(args, 0)
+		saltArray := copyBytesFromJS(args[1])
+		opts, err := kdfPbkdf2OptionsFromJS(optionalArg(args, 2))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		key, err := KdfPbkdf2(passwordBytes, saltArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回密钥字节数组
+		return successResponse(copyBytesToJS(key))
+	}))
+
+	// scrypt派生密钥，口令可为字符串或Uint8Array，选项可选
+	js.Global().Set("goKdfScrypt", ToPromise(func(args []js.Value) interface{} {
+		passwordBytes := optionalBytesArg(args, 0)
+		saltArray := copyBytesFromJS(args[1])
+		opts := kdfScryptOptionsFromJS(optionalArg(args, 2))
+
+		key, err := KdfScrypt(passwordBytes, saltArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回密钥字节数组
+		return successResponse(copyBytesToJS(key))
+	}))
+
+	// Argon2id派生密钥，口令可为字符串或Uint8Array，选项可选
+	js.Global().Set("goKdfArgon2id", ToPromise(func(args []js.Value) interface{} {
+		passwordBytes := optionalBytesArg(args, 0)
+		saltArray := copyBytesFromJS(args[1])
+		opts := kdfArgon2OptionsFromJS(optionalArg(args, 2))
+
+		key, err := KdfArgon2id(passwordBytes, saltArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回密钥字节数组
+		return successResponse(copyBytesToJS(key))
+	}))
+
+	// HKDF派生密钥，盐、info、长度和摘要算法可选
+	js.Global().Set("goKdfHkdf", ToPromise(func(args []js.Value) interface{} {
+		secretArray := copyBytesFromJS(args[0])
+		salt := optionalBytesArg(args, 1)
+		info := optionalBytesArg(args, 2)
+		length := optionalIntArg(args, 3)
+		hash, err := hashAlgorithmFromJS(optionalArg(args, 4))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		key, err := KdfHkdf(secretArray, salt, info, length, hash)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回密钥字节数组
+		return successResponse(copyBytesToJS(key))
+	}))
+
+	// 使用Argon2id计算口令哈希，返回PHC格式字符串，选项可选
+	js.Global().Set("goKdfHashPassword", ToPromise(func(args []js.Value) interface{} {
+		password := args[0].String()
+		opts := kdfArgon2OptionsFromJS(optionalArg(args, 1))
+
+		encodedHash, err := KdfHashPassword(password, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回PHC字符串
+		return successResponse(encodedHash)
+	}))
+
+	// 验证口令与PHC格式哈希是否匹配
+	js.Global().Set("goKdfVerifyPassword", ToPromise(func(args []js.Value) interface{} {
+		password := args[0].String()
+		encodedHash := args[1].String()
+
+		verified, err := KdfVerifyPassword(password, encodedHash)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回验证结果布尔值
+		return successResponse(verified)
+	}))
+}
+
+// 随机数函数导出
+func registerRandomFunctions() {
+	// 生成随机字节
+	js.Global().Set("goRandomBytes", ToPromise(func(args []js.Value) interface{} {
+		b, err := RandomBytes(args[0].Int())
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回随机字节数组
+		return successResponse(copyBytesToJS(b))
+	}))
+
+	// 生成[min, max]区间内的随机整数，超出±2^53时JS数值会丢失精度
+	js.Global().Set("goRandomInt", ToPromise(func(args []js.Value) interface{} {
+		n, err := RandomInt(int64(args[0].Int()), int64(args[1].Int()))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回随机整数
+		return successResponse(n)
+	}))
+
+	// 生成URL安全的随机令牌，字节数可选，默认32
+	js.Global().Set("goRandomToken", ToPromise(func(args []js.Value) interface{} {
+		token, err := RandomToken(optionalIntArg(args, 0))
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回令牌字符串
+		return successResponse(token)
+	}))
+
+	// 生成随机UUID（版本4）
+	js.Global().Set("goRandomUuidV4", ToPromise(func(args []js.Value) interface{} {
+		uuid, err := RandomUuidV4()
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回UUID字符串
+		return successResponse(uuid)
+	}))
+
+	// 生成按时间排序的UUID（版本7）
+	js.Global().Set("goRandomUuidV7", ToPromise(func(args []js.Value) interface{} {
+		uuid, err := RandomUuidV7()
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回UUID字符串
+		return successResponse(uuid)
+	}))
+}
+
+// JWT函数导出
+func registerJwtFunctions() {
+	// 签发JWT，claims为JSON字符串，options可选 {algorithm, kid}
+	js.Global().Set("goJwtSign", ToPromise(func(args []js.Value) interface{} {
+		claims := args[0].String()
+		privateKeyArray := copyBytesFromJS(args[1])
+		opts := jwtSignOptionsFromJS(optionalArg(args, 2))
+
+		token, err := JwtSign(claims, privateKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回令牌字符串
+		return successResponse(token)
+	}))
+
+	// 验证JWT并返回声明JSON，options可选 {algorithms, issuer, audience, clockSkew}
+	js.Global().Set("goJwtVerify", ToPromise(func(args []js.Value) interface{} {
+		token := args[0].String()
+		publicKeyArray := copyBytesFromJS(args[1])
+		opts := jwtVerifyOptionsFromJS(optionalArg(args, 2))
+
+		claims, err := JwtVerify(token, publicKeyArray, opts)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回声明JSON字符串
+		return successResponse(claims)
+	}))
+
+	// 解码JWT头部（不验证）
+	js.Global().Set("goJwtDecodeHeader", ToPromise(func(args []js.Value) interface{} {
+		token := args[0].String()
+
+		header, err := JwtDecodeHeader(token)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回头部JSON字符串
+		return successResponse(header)
+	}))
+
+	// 解码JWT声明（不验证）
+	js.Global().Set("goJwtDecodeClaims", ToPromise(func(args []js.Value) interface{} {
+		token := args[0].String()
+
+		claims, err := JwtDecodeClaims(token)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回声明JSON字符串
+		return successResponse(claims)
+	}))
+}
+
+// JWK函数导出
+func registerJwkFunctions() {
+	// 公钥转换为JWK，kid可选
+	js.Global().Set("goJwkFromPublicKey", ToPromise(func(args []js.Value) interface{} {
+		publicKeyArray := copyBytesFromJS(args[0])
+		kid := optionalStringArg(args, 1)
+
+		jwk, err := JwkFromPublicKey(publicKeyArray, kid)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回JWK字符串
+		return successResponse(jwk)
+	}))
+
+	// 私钥转换为JWK，kid可选
+	js.Global().Set("goJwkFromPrivateKey", ToPromise(func(args []js.Value) interface{} {
+		privateKeyArray := copyBytesFromJS(args[0])
+		kid := optionalStringArg(args, 1)
+
+		jwk, err := JwkFromPrivateKey(privateKeyArray, kid)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回JWK字符串
+		return successResponse(jwk)
+	}))
+
+	// JWK转换为PKIX公钥
+	js.Global().Set("goJwkToPublicKey", ToPromise(func(args []js.Value) interface{} {
+		jwk := args[0].String()
+
+		publicKey, err := JwkToPublicKey(jwk)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回公钥字节数组
+		return successResponse(copyBytesToJS(publicKey))
+	}))
+
+	// JWK转换为PKCS8私钥
+	js.Global().Set("goJwkToPrivateKey", ToPromise(func(args []js.Value) interface{} {
+		jwk := args[0].String()
+
+		privateKey, err := JwkToPrivateKey(jwk)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回私钥字节数组
+		return successResponse(copyBytesToJS(privateKey))
+	}))
+
+	// 计算JWK指纹
+	js.Global().Set("goJwkThumbprint", ToPromise(func(args []js.Value) interface{} {
+		jwk := args[0].String()
+
+		thumbprint, err := JwkThumbprint(jwk)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回指纹字符串
+		return successResponse(thumbprint)
+	}))
+
+	// 添加JWK到密钥集，jwks为空字符串时新建
+	js.Global().Set("goJwksAddKey", ToPromise(func(args []js.Value) interface{} {
+		jwks := args[0].String()
+		jwk := args[1].String()
+
+		result, err := JwksAddKey(jwks, jwk)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回JWKS字符串
+		return successResponse(result)
+	}))
+
+	// 按kid查找JWK
+	js.Global().Set("goJwksLookupKey", ToPromise(func(args []js.Value) interface{} {
+		jwks := args[0].String()
+		kid := args[1].String()
+
+		jwk, err := JwksLookupKey(jwks, kid)
+		if err != nil {
+			return errorResponse(err)
+		}
+
+		// 直接返回JWK字符串
+		return successResponse(jwk)
+	}))
 }
 
 func main() {
 	// 注册所有RSA函数
 	registerRsaFunctions()
+	// 注册信封加密函数
+	registerEnvelopeFunctions()
+	// 注册ECDSA函数
+	registerEcdsaFunctions()
+	// 注册Ed25519函数
+	registerEd25519Functions()
+	// 注册ECDH函数
+	registerEcdhFunctions()
+	// 注册AES函数
+	registerAesFunctions()
+	// 注册ChaCha20-Poly1305函数
+	registerChaCha20Poly1305Functions()
+	// 注册SM2函数
+	registerSm2Functions()
+	// 注册SM3函数
+	registerSm3Functions()
+	// 注册SM4函数
+	registerSm4Functions()
+	// 注册摘要函数
+	registerHashFunctions()
+	// 注册流式摘要和签名函数
+	registerHashStreamFunctions()
+	registerRsaStreamFunctions()
+	// 注册密钥派生函数
+	registerKdfFunctions()
+	// 注册随机数函数
+	registerRandomFunctions()
+	// 注册JWK函数
+	registerJwkFunctions()
+	// 注册JWT函数
+	registerJwtFunctions()
 
 	// 通知JS运行时WASM已准备就绪
 	js.Global().Set("goWasmReady", js.ValueOf(true))